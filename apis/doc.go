@@ -18,4 +18,7 @@ const (
 
 	// Connectivity is the Kind of the Connectivity custom resource.
 	Connectivity = "connectivity"
+
+	// TopologySnapshot is the Kind of the TopologySnapshot custom resource.
+	TopologySnapshot = "topologySnapshot"
 )
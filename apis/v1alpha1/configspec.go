@@ -111,16 +111,18 @@ type ConfigImagePull struct {
 	// launcher pods (if/when image pull through mode is auto or always). This can be useful if,
 	// for example, the CRI sock is in a "non-standard" location like K3s which puts the containerd
 	// sock at `/run/k3s/containerd/containerd.sock` rather than the "normal" (whatever that means)
-	// location of `/run/containerd/containerd.sock`. The value must end with "containerd.sock" for
-	// now, in the future maybe crio support will be added.
-	// +kubebuilder:validation:Pattern=(.*containerd\.sock)
+	// location of `/run/containerd/containerd.sock`. The value must end with "containerd.sock" or
+	// "docker.sock" -- or, for crio, it must be the containers/storage directory (ending with
+	// "storage", typically `/var/lib/containers/storage`) as crio images are read directly from
+	// the node's image storage.
+	// +kubebuilder:validation:Pattern=(.*(containerd\.sock|docker\.sock|storage))
 	// +optional
 	CRISockOverride string `json:"criSockOverride,omitempty"`
 	// CRIKindOverride allows for overriding the auto discovered cri flavor of the cluster -- this
 	// may be useful if we fail to parse the cri kind for some reason, or in mixed cri flavor
 	// clusters -- however in the latter case, make sure that if you are using image pull through
 	// that clabernetes workloads are only run on the nodes of the cri kind specified here!
-	// +kubebuilder:validation:Enum=containerd
+	// +kubebuilder:validation:Enum=containerd;crio;docker
 	// +optional
 	CRIKindOverride string `json:"criKindOverride,omitempty"`
 	// DockerDaemonConfig allows for setting a default docker daemon config for launcher pods
@@ -140,4 +142,17 @@ type ConfigImagePull struct {
 	// in here in the event your cluster doesn't support the preferred image pull through option.
 	// +optional
 	DockerConfig string `json:"dockerConfig,omitempty"`
+	// ImageCacheHostPath enables the node image cache when set -- this is the path on the
+	// kubernetes nodes that launchers use to share node images exported from the CRI (if/when
+	// image pull through mode is auto or always). Rather than each launcher exporting its own copy
+	// of an image, the first launcher on a kubernetes node exports it to the cache (keyed by image
+	// id) and any other launchers on that node reuse it. Cached images unused for seven days are
+	// evicted. For example: `/var/lib/clabernetes/image-cache`.
+	// +kubebuilder:validation:Pattern=(^/.*)
+	// +optional
+	ImageCacheHostPath string `json:"imageCacheHostPath,omitempty"`
+	// Prewarm sets the global default for pre-pulling ("prewarming") topology images onto the
+	// kubernetes nodes the launchers could be scheduled on before the launchers are deployed.
+	// +optional
+	Prewarm bool `json:"prewarm,omitempty"`
 }
@@ -10,6 +10,7 @@ import (
 // Connectivity is an object that holds information about a connectivity between launcher pods in
 // a clabernetes Topology.
 // +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
 type Connectivity struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -27,7 +28,12 @@ type ConnectivitySpec struct {
 }
 
 // ConnectivityStatus is the status for a Connectivity resource.
-type ConnectivityStatus struct{}
+type ConnectivityStatus struct {
+	// PointToPointTunnels holds the observed state of the point-to-point tunnels as reported by
+	// the launchers. The mapping is nodeName (i.e. srl1) -> p2p tunnel status.
+	// +optional
+	PointToPointTunnels map[string][]PointToPointTunnelStatus `json:"pointToPointTunnels,omitempty"`
+}
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
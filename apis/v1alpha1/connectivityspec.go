@@ -1,8 +1,11 @@
 package v1alpha1
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 // PointToPointTunnel holds information necessary for creating a tunnel between two interfaces on
 // different nodes of a clabernetes Topology. This connection can be established by using clab tools
-// (vxlan) or the experimental slurpeeth (tcp tunnel magic).
+// (vxlan), netlink (vxlan, geneve or vxlan inside of wireguard) or the experimental slurpeeth (tcp
+// tunnel magic).
 type PointToPointTunnel struct {
 	// TunnelID is the id number of the tunnel (vnid or segment id).
 	TunnelID int `json:"tunnelID"`
@@ -20,4 +23,86 @@ type PointToPointTunnel struct {
 	// can properly align tunnels (and ids!) between nodes; basically to know which tunnels are
 	// "paired up".
 	RemoteInterface string `json:"remoteInterface"`
+	// Impairment holds the impairments to apply to the local interface of this tunnel, if any.
+	// +optional
+	Impairment *LinkImpairment `json:"impairment,omitempty"`
+	// State is the desired administrative state of the local interface of this tunnel, either "up"
+	// or "down". An empty state means "up".
+	// +kubebuilder:validation:Enum=up;down
+	// +optional
+	State string `json:"state,omitempty"`
+	// WireGuard holds the wireguard peering information of this tunnel, only set when the
+	// topology uses "wireguard" connectivity.
+	// +optional
+	WireGuard *PointToPointTunnelWireGuard `json:"wireGuard,omitempty"`
+}
+
+// PointToPointTunnelWireGuard holds the information necessary to carry a tunnel through the
+// (encrypted) wireguard interfaces of the local and remote launchers.
+type PointToPointTunnelWireGuard struct {
+	// LocalAddress is the address of the wireguard interface of the local launcher.
+	LocalAddress string `json:"localAddress"`
+	// RemoteAddress is the address of the wireguard interface of the remote launcher, this is
+	// the address the tunnel is terminated on in the remote launcher.
+	RemoteAddress string `json:"remoteAddress"`
+	// RemotePublicKey is the (base64 encoded) wireguard public key of the remote launcher.
+	RemotePublicKey string `json:"remotePublicKey"`
+}
+
+// PointToPointTunnelStatus holds the observed state of one side of a point-to-point tunnel as
+// reported by the launcher of the local node.
+type PointToPointTunnelStatus struct {
+	// TunnelID is the id number of the tunnel (vnid or segment id).
+	TunnelID int `json:"tunnelID"`
+	// LocalNode is the local node (in the clabernetes topology) of this tunnel.
+	// +optional
+	LocalNode string `json:"localNode,omitempty"`
+	// LocalInterface is the local termination of this tunnel.
+	LocalInterface string `json:"localInterface"`
+	// State is the administrative state, either "up" or "down", last applied to the local
+	// interface of this tunnel.
+	State string `json:"state"`
+	// InterfaceExists indicates if the (launcher side) local interface of this tunnel exists.
+	InterfaceExists bool `json:"interfaceExists"`
+	// OperState is the operational state of the local interface of this tunnel as reported by the
+	// kernel, for example "up", "down" or "unknown".
+	// +optional
+	OperState string `json:"operState,omitempty"`
+	// RxBytes is the number of bytes received on the local interface of this tunnel.
+	// +optional
+	RxBytes int64 `json:"rxBytes,omitempty"`
+	// RxPackets is the number of packets received on the local interface of this tunnel.
+	// +optional
+	RxPackets int64 `json:"rxPackets,omitempty"`
+	// TxBytes is the number of bytes transmitted on the local interface of this tunnel.
+	// +optional
+	TxBytes int64 `json:"txBytes,omitempty"`
+	// TxPackets is the number of packets transmitted on the local interface of this tunnel.
+	// +optional
+	TxPackets int64 `json:"txPackets,omitempty"`
+	// LastUpdateTime is the time the launcher last reported the state of this tunnel.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// LinkImpairment holds netem style impairments to apply to the traffic a node sends out of an
+// interface.
+type LinkImpairment struct {
+	// Delay is the delay (latency) to add to packets, as a go duration string, for example "50ms".
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|ms|s|m|h))+$`
+	// +optional
+	Delay string `json:"delay,omitempty"`
+	// Jitter is the variation of the delay, as a go duration string, for example "5ms". Jitter is
+	// only applied if a delay is set.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|ms|s|m|h))+$`
+	// +optional
+	Jitter string `json:"jitter,omitempty"`
+	// Loss is the percentage of packets to drop, for example "0.5" to drop 0.5% of packets.
+	// +kubebuilder:validation:Pattern=`^(100(\.0+)?|[0-9]{1,2}(\.[0-9]+)?)$`
+	// +optional
+	Loss string `json:"loss,omitempty"`
+	// Rate is the rate limit in kbit/s, unset (or zero) means no rate limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Rate int `json:"rate,omitempty"`
 }
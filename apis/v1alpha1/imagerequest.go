@@ -45,6 +45,33 @@ type ImageRequestStatus struct {
 	// Complete indicates that the ImageRequest controller has seen that the puller pod has done its
 	// job and that the image has been pulled onto the requested node.
 	Complete bool `json:"complete"`
+	// Attempts is the number of times the ImageRequest controller has attempted to pull the image
+	// (spawned a puller pod) for this image request.
+	// +optional
+	Attempts int `json:"attempts,omitempty"`
+	// FailureReason is the reason the last pull attempt failed as reported by the kubelet for the
+	// puller pod container, for example "ErrImagePull" or "InvalidImageName".
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+	// FailureMessage is the message accompanying the failure reason, this usually holds the actual
+	// registry error -- for example the image not being found or the registry refusing our
+	// credentials.
+	// +optional
+	FailureMessage string `json:"failureMessage,omitempty"`
+	// NextAttemptTime is the time of the next pull attempt after a failed attempt that is going to
+	// be retried, the ImageRequest controller backs off until then.
+	// +optional
+	NextAttemptTime *metav1.Time `json:"nextAttemptTime,omitempty"`
+	// CompletionTime is the time the image request was completed, either because the image was
+	// pulled or because all pull attempts failed. The image request is deleted once its time to
+	// live has passed since this time.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Conditions is a list of conditions for the image request, the "Pulled" condition reflects
+	// the state of the image pull on the requested node.
+	// +listType=atomic
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -116,10 +116,29 @@ type TopologySpec struct {
 	// Connectivity defines the type of connectivity to use between nodes in the topology. The
 	// default behavior is to use vxlan tunnels, alternatively you can enable a more experimental
 	// "slurpeeth" connectivity flavor that stuffs traffic into tcp tunnels to avoid any vxlan mtu
-	// and/or fragmentation challenges.
-	// +kubebuilder:validation:Enum=vxlan;slurpeeth
+	// and/or fragmentation challenges, "vxlan-netlink" which sets up the same vxlan tunnels as
+	// "vxlan" but natively via netlink rather than by shelling out to containerlab, "geneve" which
+	// sets up geneve tunnels (via netlink), or "wireguard" which carries vxlan tunnels through
+	// encrypted wireguard interfaces between the launchers (keys are generated per topology and
+	// stored in a secret).
+	// +kubebuilder:validation:Enum=vxlan;slurpeeth;vxlan-netlink;geneve;wireguard
 	// +kubebuilder:default=vxlan
 	Connectivity string `json:"connectivity,omitempty"`
+	// LinkImpairments is a list of netem style impairments (delay, jitter, loss, rate) to apply to
+	// links of the topology. Impairments are applied by the launchers on both sides of a link (to
+	// the traffic each node sends over the link) and are updated live when changed, the launchers
+	// are not restarted. Only links between nodes in different launchers can be impaired, links
+	// between nodes in the same launcher (or to the host) are left untouched.
+	// +listType=atomic
+	// +optional
+	LinkImpairments []TopologyLinkImpairment `json:"linkImpairments,omitempty"`
+	// LinkStates is a list of administrative state overrides for links of the topology, setting
+	// a link "down" sets the interfaces of both sides of the link down without redeploying
+	// anything, removing the override (or setting it "up") brings the link back up. Like
+	// impairments, only links between nodes in different launchers can be set down.
+	// +listType=atomic
+	// +optional
+	LinkStates []TopologyLinkState `json:"linkStates,omitempty"`
 }
 
 // TopologyStatus is the status for a Topology resource.
@@ -155,6 +174,17 @@ type TopologyStatus struct {
 	// NodeProbeStatuses is a map of node name to per-probe status information.
 	// +optional
 	NodeProbeStatuses map[string]NodeProbeStatuses `json:"nodeProbeStatuses,omitempty"`
+	// NodeRestarts is a map of node name to the number of times the node has been restarted since
+	// its launcher pod was created -- this includes restarts of the node container by the launcher
+	// (liveness restart policy "node") as well as restarts of the launcher itself. A steadily
+	// increasing count usually points to a flaky node image.
+	// +optional
+	NodeRestarts map[string]int `json:"nodeRestarts,omitempty"`
+	// ImagePrewarm holds the progress of pre-pulling the topology images when image prewarming is
+	// enabled. It is a map of image -> kubernetes node -> prewarm state, the state is one of
+	// "pulling", "pulled" or "failed".
+	// +optional
+	ImagePrewarm map[string]map[string]string `json:"imagePrewarm,omitempty"`
 	// Conditions is a list of conditions for the topology custom resource.
 	// +listType=atomic
 	Conditions []metav1.Condition `json:"conditions"`
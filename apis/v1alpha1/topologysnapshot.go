@@ -0,0 +1,133 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// TopologySnapshotPhase represents the overall phase of a TopologySnapshot.
+// +kubebuilder:validation:Enum=pending;running;completed;failed
+type TopologySnapshotPhase string
+
+const (
+	// TopologySnapshotPhasePending indicates the snapshot has not been started yet.
+	TopologySnapshotPhasePending TopologySnapshotPhase = "pending"
+
+	// TopologySnapshotPhaseRunning indicates the snapshot is in progress.
+	TopologySnapshotPhaseRunning TopologySnapshotPhase = "running"
+
+	// TopologySnapshotPhaseCompleted indicates the configuration of every node in the topology
+	// was captured successfully.
+	TopologySnapshotPhaseCompleted TopologySnapshotPhase = "completed"
+
+	// TopologySnapshotPhaseFailed indicates the snapshot could not be started or that capturing
+	// the configuration of one or more nodes failed.
+	TopologySnapshotPhaseFailed TopologySnapshotPhase = "failed"
+)
+
+// TopologySnapshotNodePhase represents the phase of the snapshot of a single node.
+// +kubebuilder:validation:Enum=pending;succeeded;failed
+type TopologySnapshotNodePhase string
+
+const (
+	// TopologySnapshotNodePhasePending indicates the node has not been snapshotted yet.
+	TopologySnapshotNodePhasePending TopologySnapshotNodePhase = "pending"
+
+	// TopologySnapshotNodePhaseSucceeded indicates the node configuration was captured.
+	TopologySnapshotNodePhaseSucceeded TopologySnapshotNodePhase = "succeeded"
+
+	// TopologySnapshotNodePhaseFailed indicates capturing the node configuration failed.
+	TopologySnapshotNodePhaseFailed TopologySnapshotNodePhase = "failed"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TopologySnapshot is an object that represents a request to capture ("containerlab save") the
+// running configuration of every node in a Topology. The captured configuration artifacts are
+// stored in a ConfigMap per node, owned by the TopologySnapshot.
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:path="topologysnapshots"
+// +kubebuilder:printcolumn:JSONPath=".spec.topologyName",name=Topology,type=string
+// +kubebuilder:printcolumn:JSONPath=".status.phase",name=Phase,type=string
+// +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name=Age,type=date
+type TopologySnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TopologySnapshotSpec   `json:"spec,omitempty"`
+	Status TopologySnapshotStatus `json:"status,omitempty"`
+}
+
+// TopologySnapshotSpec is the spec for a TopologySnapshot resource.
+type TopologySnapshotSpec struct {
+	// TopologyName is the name of the Topology (in the same namespace as the TopologySnapshot) to
+	// capture the node configurations of.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="topologyName field is immutable"
+	TopologyName string `json:"topologyName"`
+}
+
+// TopologySnapshotStatus is the status for a TopologySnapshot resource.
+type TopologySnapshotStatus struct {
+	// Phase is the overall phase of the snapshot.
+	// +kubebuilder:validation:Enum=pending;running;completed;failed
+	// +optional
+	Phase TopologySnapshotPhase `json:"phase,omitempty"`
+	// Message holds a human-readable message about the phase of the snapshot, for example why the
+	// snapshot failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the snapshot was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the snapshot finished (successfully or not).
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// TotalNodes is the number of nodes in the topology being snapshotted.
+	// +optional
+	TotalNodes int `json:"totalNodes"`
+	// ProcessedNodes is the number of nodes that have been snapshotted so far, whether
+	// successfully or not.
+	// +optional
+	ProcessedNodes int `json:"processedNodes"`
+	// Nodes is a map of node name to the snapshot status of that node.
+	// +optional
+	Nodes map[string]TopologySnapshotNodeStatus `json:"nodes,omitempty"`
+}
+
+// TopologySnapshotNodeStatus is the snapshot status of a single node in a TopologySnapshot.
+type TopologySnapshotNodeStatus struct {
+	// Phase is the phase of the snapshot of this node.
+	// +kubebuilder:validation:Enum=pending;succeeded;failed
+	Phase TopologySnapshotNodePhase `json:"phase"`
+	// Kind is the containerlab kind of the node.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Message holds a human-readable message about the snapshot of this node, for example why the
+	// snapshot of the node failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// ConfigMapName is the name of the ConfigMap the node configuration artifacts are stored in.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// Artifacts is the list of configuration artifacts captured for this node.
+	// +listType=atomic
+	// +optional
+	Artifacts []TopologySnapshotArtifact `json:"artifacts,omitempty"`
+}
+
+// TopologySnapshotArtifact is a single file captured during the snapshot of a node.
+type TopologySnapshotArtifact struct {
+	// Path is the path of the file relative to the node directory in the containerlab lab
+	// directory.
+	Path string `json:"path"`
+	// Key is the key in the node ConfigMap that holds the contents of the file.
+	Key string `json:"key"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TopologySnapshotList is a list of TopologySnapshot objects.
+type TopologySnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TopologySnapshot `json:"items"`
+}
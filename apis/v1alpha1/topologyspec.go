@@ -33,6 +33,31 @@ type FileFromURL struct {
 	URL string `json:"url"`
 }
 
+// TopologyLinkImpairment associates a LinkImpairment with a link of the topology definition.
+type TopologyLinkImpairment struct {
+	// Endpoints are the two endpoints ("node:interface") of the link to impair, exactly as in the
+	// topology definition. The order of the endpoints does not matter.
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	// +listType=atomic
+	Endpoints []string `json:"endpoints"`
+	// Impairment is the impairment to apply to both directions of the link.
+	Impairment LinkImpairment `json:"impairment"`
+}
+
+// TopologyLinkState associates an administrative state with a link of the topology definition.
+type TopologyLinkState struct {
+	// Endpoints are the two endpoints ("node:interface") of the link, exactly as in the topology
+	// definition. The order of the endpoints does not matter.
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	// +listType=atomic
+	Endpoints []string `json:"endpoints"`
+	// State is the desired administrative state of the link, either "up" or "down".
+	// +kubebuilder:validation:Enum=up;down
+	State string `json:"state"`
+}
+
 // Persistence holds information about how to persist the containlerab lab directory for each node
 // in a topology.
 type Persistence struct {
@@ -51,6 +76,11 @@ type Persistence struct {
 	// have (as default) or provide a dynamically provisionable storage class, hence no selector.
 	// +optional
 	StorageClassName string `json:"storageClassName,omitempty"`
+	// SaveOnTeardown, when true, causes each launcher to run "containerlab save" (saving the
+	// running configuration of the node into the persisted lab directory) before destroying the
+	// lab when the Topology is deleted. This has no effect if persistence is not enabled.
+	// +optional
+	SaveOnTeardown bool `json:"saveOnTeardown,omitempty"`
 }
 
 // InsecureRegistries is a slice of strings of insecure registries to configure in the launcher
@@ -110,7 +140,10 @@ type Expose struct {
 	//         DNS records that return pod IPs.
 	// - LoadBalancer: (default) creates a load balancer service so you can access your pods from
 	//         outside the cluster. this is/was the only behavior up to v0.2.4.
-	// +kubebuilder:validation:Enum=None;ClusterIP;Headless;LoadBalancer
+	// - SharedLoadBalancer: creates a single load balancer service for the whole topology, each
+	//         exposed node port is mapped to a distinct port on that load balancer -- the mapping
+	//         is reported in the topology status exposed ports. Nodes also get a clusterip service.
+	// +kubebuilder:validation:Enum=None;ClusterIP;Headless;LoadBalancer;SharedLoadBalancer
 	// +kubebuilder:default=LoadBalancer
 	// +optional
 	ExposeType string `json:"exposeType,omitempty"`
@@ -147,6 +180,17 @@ type Deployment struct {
 	// to "scheduling" things (affinity/node selector/tolerations).
 	// +optional
 	Scheduling Scheduling `json:"scheduling"`
+	// NodeGroups is a mapping of node name to a list of (other) node names that should be
+	// co-located with that node -- that is, deployed in the same launcher pod. The nodes of a group
+	// are rendered into a single sub-topology (and a single launcher Deployment named after the
+	// "primary" node, the key of the mapping); links between the nodes of a group stay native veth
+	// links, only links to nodes outside the group become tunnels. This is useful for traffic-heavy
+	// node pairs such as a traffic generator and its DUT. Only the primary node of a group is
+	// exposed, a node may only be part of one group, and bridge nodes cannot be grouped. Nodes that
+	// share a network namespace (network-mode "container:<primary>") are always co-located with
+	// their primary and do not need to be listed here. Only applies to containerlab topologies.
+	// +optional
+	NodeGroups map[string][]string `json:"nodeGroups,omitempty"`
 	// PrivilegedLauncher, when true, sets the launcher containers to privileged. Historically we
 	// tried very hard to *not* need to set privileged mode on pods, however the reality is it is
 	// much, much easier to get various network operating system images booting with this enabled,
@@ -170,6 +214,15 @@ type Deployment struct {
 	// larger than the ConfigMap (etcd) 1Mb size limit.
 	// +optional
 	FilesFromURL map[string][]FileFromURL `json:"filesFromURL"`
+	// RestoreFromSnapshot is the name of a (completed) TopologySnapshot in the namespace of the
+	// topology to restore the node configurations from. When set, the configuration captured for
+	// each node in the snapshot is mounted in the launcher and used as the (enforced)
+	// startup-config of the node, and the launchers of the affected nodes are restarted. Changing
+	// the snapshot restores the nodes to that snapshot, clearing it restarts the nodes with their
+	// original startup-config. Nodes are matched by name, nodes that were not captured in the
+	// snapshot are left untouched.
+	// +optional
+	RestoreFromSnapshot string `json:"restoreFromSnapshot,omitempty"`
 	// Persistence holds configurations relating to persisting each nodes working containerlab
 	// directory.
 	// +optional
@@ -211,6 +264,14 @@ type Deployment struct {
 	// +kubebuilder:validation:Enum=disabled;critical;warn;info;debug
 	// +optional
 	LauncherLogLevel string `json:"launcherLogLevel,omitempty"`
+	// LauncherRuntime sets the container runtime the launcher uses to run the containerlab
+	// sub-topology. The default, "docker", runs a nested docker daemon in each launcher. "podman"
+	// instead uses containerlab's podman runtime so no docker daemon is started at all; this avoids
+	// docker-in-docker but note that the launcher still needs enough privileges to create the
+	// node containers and their network interfaces.
+	// +kubebuilder:validation:Enum=docker;podman
+	// +optional
+	LauncherRuntime string `json:"launcherRuntime,omitempty"`
 	// ExtraEnv is a list of additional environment variables to set on the launcher container. The
 	// values here override any configured global config extra envs!
 	// +optional
@@ -259,8 +320,8 @@ type StatusProbes struct {
 }
 
 // ProbeConfiguration holds information about how to probe a (containerlab) node in a Topology. If
-// both style probes are configured, both will be used and both must succeed in order to report
-// healthy.
+// multiple probes are configured, all of them will be used and all must succeed in order to
+// report healthy.
 type ProbeConfiguration struct {
 	// StartupSeconds is the total amount of seconds to allow for the node to start. This defaults
 	// to ~13 minutes to hopefully account for slow to boot nodes. Note that there is also a 60
@@ -271,23 +332,83 @@ type ProbeConfiguration struct {
 	// over anyway.
 	// +optional
 	StartupSeconds int `json:"startupSeconds"`
+	// InitialDelaySeconds is the delay before the startup probe starts checking the node status,
+	// defaults to 60 seconds. Lightweight nodes that are ready within seconds may want to set this
+	// to a (much) lower value.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InitialDelaySeconds *int `json:"initialDelaySeconds,omitempty"`
+	// IntervalSeconds is the interval at which the launcher probes the node and at which the
+	// startup/readiness (and if enabled liveness) probes check the result, defaults to 20 seconds.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	IntervalSeconds int `json:"intervalSeconds,omitempty"`
+	// TimeoutSeconds is the timeout for the launcher probe attempts, defaults to 5 seconds.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+	// ReadinessFailureThreshold is the number of consecutive failed checks after which the node is
+	// reported as not ready, defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ReadinessFailureThreshold int `json:"readinessFailureThreshold,omitempty"`
+	// LivenessFailureThreshold is the number of consecutive failed checks (after the node has
+	// started) after which the node is considered dead and the LivenessRestartPolicy is applied.
+	// Unset (or zero) means there is no liveness probe and the node is never restarted due to
+	// failing checks.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	LivenessFailureThreshold int `json:"livenessFailureThreshold,omitempty"`
+	// LivenessRestartPolicy is what happens when the liveness probe fails, it only has an effect
+	// if LivenessFailureThreshold is set. "never" only reports the liveness status, "node"
+	// redeploys the containerlab node(s) of the launcher (and re-applies their tunnels) and
+	// "launcher" (the default) restarts the launcher (and so the node along with it) via a k8s
+	// liveness probe.
+	// +kubebuilder:validation:Enum=never;node;launcher
+	// +optional
+	LivenessRestartPolicy string `json:"livenessRestartPolicy,omitempty"`
 	// SSHProbeConfiguration defines an SSH probe.
 	// +optional
 	SSHProbeConfiguration *SSHProbeConfiguration `json:"sshProbeConfiguration,omitempty"`
 	// TCPProbeConfiguration defines a TCP probe.
 	// +optional
 	TCPProbeConfiguration *TCPProbeConfiguration `json:"tcpProbeConfiguration,omitempty"`
+	// HTTPProbeConfiguration defines an HTTP(S) probe.
+	// +optional
+	HTTPProbeConfiguration *HTTPProbeConfiguration `json:"httpProbeConfiguration,omitempty"`
+	// GNMIProbeConfiguration defines a gNMI probe.
+	// +optional
+	GNMIProbeConfiguration *GNMIProbeConfiguration `json:"gnmiProbeConfiguration,omitempty"`
+	// NETCONFProbeConfiguration defines a NETCONF probe.
+	// +optional
+	NETCONFProbeConfiguration *NETCONFProbeConfiguration `json:"netconfProbeConfiguration,omitempty"` //nolint:lll
+	// ExecProbeConfiguration defines an exec probe.
+	// +optional
+	ExecProbeConfiguration *ExecProbeConfiguration `json:"execProbeConfiguration,omitempty"`
 }
 
 // SSHProbeConfiguration defines a "ssh" probe -- the ssh probe just connects using standard go
-// crypto ssh setup and reports true if auth is successful, it does no further checking. The probe
-// is executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s
-// probe can pick it up and reflect the status.
+// crypto ssh setup and reports true if auth (password or public key) is successful, it does no
+// further checking. The probe is executed by the launcher and the result is placed into
+// /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status. Credentials can
+// be set inline (Username/Password) or, so they do not show up in the launcher Deployment, be
+// sourced from a Secret via CredentialsSecret.
 type SSHProbeConfiguration struct {
-	// Username is the username to use for auth.
+	// Username is the username to use for auth, required unless CredentialsSecret is set.
+	// +optional
 	Username string `json:"username"`
-	// Password is the password to use for auth.
+	// Password is the password to use for auth, required unless CredentialsSecret is set. Note
+	// that this ends up in plain text in the launcher Deployment, prefer CredentialsSecret.
+	// +optional
 	Password string `json:"password"`
+	// CredentialsSecret is the name of a Secret in the namespace of the Topology holding the
+	// credentials to use for auth. The "username", "password" and "ssh-privatekey" keys (as used
+	// by the kubernetes.io/basic-auth and kubernetes.io/ssh-auth Secret types) are used if
+	// present, the username and password set inline take precedence over the ones in the Secret.
+	// The values are injected into the launcher via environment variable references and so never
+	// show up in the launcher Deployment itself.
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
 	// Port is an optional override (of course default is 22).
 	// +optional
 	Port int `json:"port"`
@@ -304,6 +425,92 @@ type TCPProbeConfiguration struct {
 	Port int `json:"port"`
 }
 
+// HTTPProbeConfiguration defines a "http" probe -- the probe sends a GET request to the node and
+// is successful if the response has the expected status code. Certificates are not verified when
+// using HTTPS, redirects are not followed. The probe is executed by the launcher and the result is
+// placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status.
+type HTTPProbeConfiguration struct {
+	// Port is the port to send the request to.
+	Port int `json:"port"`
+	// Path is the path to request, defaults to "/".
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Scheme is the scheme to use for the request, defaults to "HTTP".
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+	// ExpectedStatusCode is the status code the response must have, if unset any 2xx or 3xx status
+	// code is considered successful.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ExpectedStatusCode int `json:"expectedStatusCode,omitempty"`
+}
+
+// GNMIProbeConfiguration defines a "gnmi" probe -- the probe sends a gNMI capabilities request to
+// the node and is successful if the node answers it. Unless Insecure is set the connection uses
+// TLS (without verifying the certificate of the node). The probe is executed by the launcher and
+// the result is placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect
+// the status.
+type GNMIProbeConfiguration struct {
+	// Port is the port of the gNMI server.
+	Port int `json:"port"`
+	// Username is the username to use for auth, if unset (and not set in CredentialsSecret) no
+	// credentials are sent.
+	// +optional
+	Username string `json:"username,omitempty"`
+	// Password is the password to use for auth. Note that this ends up in plain text in the
+	// launcher Deployment, prefer CredentialsSecret.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// CredentialsSecret is the name of a Secret in the namespace of the Topology holding the
+	// credentials to use for auth. The "username" and "password" keys (as used by the
+	// kubernetes.io/basic-auth Secret type) are used if present, the username and password set
+	// inline take precedence over the ones in the Secret.
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+	// Insecure disables TLS, meaning the probe uses a plaintext connection.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// NETCONFProbeConfiguration defines a "netconf" probe -- the probe opens the netconf ssh
+// subsystem of the node and is successful if the node sends its hello message. The probe is
+// executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s probe
+// can pick it up and reflect the status.
+type NETCONFProbeConfiguration struct {
+	// Username is the username to use for auth, required unless CredentialsSecret is set.
+	// +optional
+	Username string `json:"username"`
+	// Password is the password to use for auth, required unless CredentialsSecret is set. Note
+	// that this ends up in plain text in the launcher Deployment, prefer CredentialsSecret.
+	// +optional
+	Password string `json:"password"`
+	// CredentialsSecret is the name of a Secret in the namespace of the Topology holding the
+	// credentials to use for auth. The "username" and "password" keys (as used by the
+	// kubernetes.io/basic-auth Secret type) are used if present, the username and password set
+	// inline take precedence over the ones in the Secret.
+	// +optional
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+	// Port is an optional override (of course default is 830).
+	// +optional
+	Port int `json:"port"`
+}
+
+// ExecProbeConfiguration defines an "exec" probe -- the probe executes a command in the node
+// container and is successful if the command exits successfully and (if set) its output matches
+// the expected output. The probe is executed by the launcher and the result is placed into
+// /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status.
+type ExecProbeConfiguration struct {
+	// Command is the command (and its arguments) to execute in the node container.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Command []string `json:"command"`
+	// ExpectedOutput is a regular expression the (combined stdout and stderr) output of the command
+	// must match.
+	// +optional
+	ExpectedOutput string `json:"expectedOutput,omitempty"`
+}
+
 // ImagePull holds configurations relevant to how clabernetes launcher pods handle pulling
 // images.
 type ImagePull struct {
@@ -340,4 +547,11 @@ type ImagePull struct {
 	// in here in the event your cluster doesn't support the preferred image pull through option.
 	// +optional
 	DockerConfig string `json:"dockerConfig,omitempty"`
+	// Prewarm enables pre-pulling ("prewarming") the images of this topology. When enabled, the
+	// controller requests every image of the topology to be pulled onto all kubernetes nodes that
+	// the launchers using that image could be scheduled on, before the launchers are deployed.
+	// Pre-pulling is done via the image pull through machinery, so it has no effect if image pull
+	// through mode is never. If unset, the global config value is used.
+	// +optional
+	Prewarm *bool `json:"prewarm,omitempty"`
 }
@@ -43,4 +43,9 @@ type ExposedPorts struct {
 	// UDPPorts is a list of UDP ports exposed on the LoadBalancer service.
 	// +listType=set
 	UDPPorts []int `json:"udpPorts"`
+	// ExternalPorts maps the exposed ports of the node, as "<port>/<protocol>" (i.e. "22/tcp"), to
+	// the port they are reachable on at the load balancer address. This is only set when using the
+	// SharedLoadBalancer expose type, as all nodes then share one load balancer address.
+	// +optional
+	ExternalPorts map[string]int `json:"externalPorts,omitempty"`
 }
@@ -245,7 +245,7 @@ func (in *Connectivity) DeepCopyInto(out *Connectivity) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -317,7 +317,7 @@ func (in *ConnectivitySpec) DeepCopyInto(out *ConnectivitySpec) {
 					if (*in)[i] != nil {
 						in, out := &(*in)[i], &(*out)[i]
 						*out = new(PointToPointTunnel)
-						**out = **in
+						(*in).DeepCopyInto(*out)
 					}
 				}
 			}
@@ -340,6 +340,24 @@ func (in *ConnectivitySpec) DeepCopy() *ConnectivitySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectivityStatus) DeepCopyInto(out *ConnectivityStatus) {
 	*out = *in
+	if in.PointToPointTunnels != nil {
+		in, out := &in.PointToPointTunnels, &out.PointToPointTunnels
+		*out = make(map[string][]PointToPointTunnelStatus, len(*in))
+		for key, val := range *in {
+			var outVal []PointToPointTunnelStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]PointToPointTunnelStatus, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -380,6 +398,21 @@ func (in *Deployment) DeepCopyInto(out *Deployment) {
 		}
 	}
 	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	if in.NodeGroups != nil {
+		in, out := &in.NodeGroups, &out.NodeGroups
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.PrivilegedLauncher != nil {
 		in, out := &in.PrivilegedLauncher, &out.PrivilegedLauncher
 		*out = new(bool)
@@ -441,6 +474,27 @@ func (in *Deployment) DeepCopy() *Deployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecProbeConfiguration) DeepCopyInto(out *ExecProbeConfiguration) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecProbeConfiguration.
+func (in *ExecProbeConfiguration) DeepCopy() *ExecProbeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecProbeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Expose) DeepCopyInto(out *Expose) {
 	*out = *in
@@ -470,6 +524,13 @@ func (in *ExposedPorts) DeepCopyInto(out *ExposedPorts) {
 		*out = make([]int, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExternalPorts != nil {
+		in, out := &in.ExternalPorts, &out.ExternalPorts
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -515,6 +576,38 @@ func (in *FileFromURL) DeepCopy() *FileFromURL {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GNMIProbeConfiguration) DeepCopyInto(out *GNMIProbeConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GNMIProbeConfiguration.
+func (in *GNMIProbeConfiguration) DeepCopy() *GNMIProbeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GNMIProbeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPProbeConfiguration) DeepCopyInto(out *HTTPProbeConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProbeConfiguration.
+func (in *HTTPProbeConfiguration) DeepCopy() *HTTPProbeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProbeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImagePull) DeepCopyInto(out *ImagePull) {
 	*out = *in
@@ -528,6 +621,11 @@ func (in *ImagePull) DeepCopyInto(out *ImagePull) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Prewarm != nil {
+		in, out := &in.Prewarm, &out.Prewarm
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -547,7 +645,7 @@ func (in *ImageRequest) DeepCopyInto(out *ImageRequest) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -626,6 +724,21 @@ func (in *ImageRequestSpec) DeepCopy() *ImageRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageRequestStatus) DeepCopyInto(out *ImageRequestStatus) {
 	*out = *in
+	if in.NextAttemptTime != nil {
+		in, out := &in.NextAttemptTime, &out.NextAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -675,6 +788,38 @@ func (in *LinkEndpoint) DeepCopy() *LinkEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinkImpairment) DeepCopyInto(out *LinkImpairment) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkImpairment.
+func (in *LinkImpairment) DeepCopy() *LinkImpairment {
+	if in == nil {
+		return nil
+	}
+	out := new(LinkImpairment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NETCONFProbeConfiguration) DeepCopyInto(out *NETCONFProbeConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NETCONFProbeConfiguration.
+func (in *NETCONFProbeConfiguration) DeepCopy() *NETCONFProbeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NETCONFProbeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeProbeStatuses) DeepCopyInto(out *NodeProbeStatuses) {
 	*out = *in
@@ -710,6 +855,16 @@ func (in *Persistence) DeepCopy() *Persistence {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PointToPointTunnel) DeepCopyInto(out *PointToPointTunnel) {
 	*out = *in
+	if in.Impairment != nil {
+		in, out := &in.Impairment, &out.Impairment
+		*out = new(LinkImpairment)
+		**out = **in
+	}
+	if in.WireGuard != nil {
+		in, out := &in.WireGuard, &out.WireGuard
+		*out = new(PointToPointTunnelWireGuard)
+		**out = **in
+	}
 	return
 }
 
@@ -723,9 +878,47 @@ func (in *PointToPointTunnel) DeepCopy() *PointToPointTunnel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PointToPointTunnelStatus) DeepCopyInto(out *PointToPointTunnelStatus) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PointToPointTunnelStatus.
+func (in *PointToPointTunnelStatus) DeepCopy() *PointToPointTunnelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PointToPointTunnelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PointToPointTunnelWireGuard) DeepCopyInto(out *PointToPointTunnelWireGuard) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PointToPointTunnelWireGuard.
+func (in *PointToPointTunnelWireGuard) DeepCopy() *PointToPointTunnelWireGuard {
+	if in == nil {
+		return nil
+	}
+	out := new(PointToPointTunnelWireGuard)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeConfiguration) DeepCopyInto(out *ProbeConfiguration) {
 	*out = *in
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int)
+		**out = **in
+	}
 	if in.SSHProbeConfiguration != nil {
 		in, out := &in.SSHProbeConfiguration, &out.SSHProbeConfiguration
 		*out = new(SSHProbeConfiguration)
@@ -736,6 +929,26 @@ func (in *ProbeConfiguration) DeepCopyInto(out *ProbeConfiguration) {
 		*out = new(TCPProbeConfiguration)
 		**out = **in
 	}
+	if in.HTTPProbeConfiguration != nil {
+		in, out := &in.HTTPProbeConfiguration, &out.HTTPProbeConfiguration
+		*out = new(HTTPProbeConfiguration)
+		**out = **in
+	}
+	if in.GNMIProbeConfiguration != nil {
+		in, out := &in.GNMIProbeConfiguration, &out.GNMIProbeConfiguration
+		*out = new(GNMIProbeConfiguration)
+		**out = **in
+	}
+	if in.NETCONFProbeConfiguration != nil {
+		in, out := &in.NETCONFProbeConfiguration, &out.NETCONFProbeConfiguration
+		*out = new(NETCONFProbeConfiguration)
+		**out = **in
+	}
+	if in.ExecProbeConfiguration != nil {
+		in, out := &in.ExecProbeConfiguration, &out.ExecProbeConfiguration
+		*out = new(ExecProbeConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -931,6 +1144,49 @@ func (in *Topology) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyLinkImpairment) DeepCopyInto(out *TopologyLinkImpairment) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Impairment = in.Impairment
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyLinkImpairment.
+func (in *TopologyLinkImpairment) DeepCopy() *TopologyLinkImpairment {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyLinkImpairment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyLinkState) DeepCopyInto(out *TopologyLinkState) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyLinkState.
+func (in *TopologyLinkState) DeepCopy() *TopologyLinkState {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyLinkState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyList) DeepCopyInto(out *TopologyList) {
 	*out = *in
@@ -964,6 +1220,151 @@ func (in *TopologyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySnapshot) DeepCopyInto(out *TopologySnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySnapshot.
+func (in *TopologySnapshot) DeepCopy() *TopologySnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TopologySnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySnapshotArtifact) DeepCopyInto(out *TopologySnapshotArtifact) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySnapshotArtifact.
+func (in *TopologySnapshotArtifact) DeepCopy() *TopologySnapshotArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySnapshotArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySnapshotList) DeepCopyInto(out *TopologySnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TopologySnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySnapshotList.
+func (in *TopologySnapshotList) DeepCopy() *TopologySnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TopologySnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySnapshotNodeStatus) DeepCopyInto(out *TopologySnapshotNodeStatus) {
+	*out = *in
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]TopologySnapshotArtifact, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySnapshotNodeStatus.
+func (in *TopologySnapshotNodeStatus) DeepCopy() *TopologySnapshotNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySnapshotNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySnapshotSpec) DeepCopyInto(out *TopologySnapshotSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySnapshotSpec.
+func (in *TopologySnapshotSpec) DeepCopy() *TopologySnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySnapshotStatus) DeepCopyInto(out *TopologySnapshotStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make(map[string]TopologySnapshotNodeStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySnapshotStatus.
+func (in *TopologySnapshotStatus) DeepCopy() *TopologySnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologySpec) DeepCopyInto(out *TopologySpec) {
 	*out = *in
@@ -972,6 +1373,20 @@ func (in *TopologySpec) DeepCopyInto(out *TopologySpec) {
 	in.Deployment.DeepCopyInto(&out.Deployment)
 	in.StatusProbes.DeepCopyInto(&out.StatusProbes)
 	in.ImagePull.DeepCopyInto(&out.ImagePull)
+	if in.LinkImpairments != nil {
+		in, out := &in.LinkImpairments, &out.LinkImpairments
+		*out = make([]TopologyLinkImpairment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LinkStates != nil {
+		in, out := &in.LinkStates, &out.LinkStates
+		*out = make([]TopologyLinkState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1030,6 +1445,30 @@ func (in *TopologyStatus) DeepCopyInto(out *TopologyStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.NodeRestarts != nil {
+		in, out := &in.NodeRestarts, &out.NodeRestarts
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ImagePrewarm != nil {
+		in, out := &in.ImagePrewarm, &out.ImagePrewarm
+		*out = make(map[string]map[string]string, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
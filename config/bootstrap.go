@@ -30,6 +30,8 @@ type bootstrapConfig struct {
 	launcherLogLevel            string
 	criSockOverride             string
 	criKindOverride             string
+	imageCacheHostPath          string
+	imagePullPrewarm            bool
 	naming                      string
 	containerlabVersion         string
 	extraEnv                    []k8scorev1.EnvVar
@@ -156,6 +158,18 @@ func bootstrapFromConfigMap( //nolint:gocyclo,funlen,gocognit
 		bc.criKindOverride = criKindOverride
 	}
 
+	imageCacheHostPath, imageCacheHostPathOk := inMap["imageCacheHostPath"]
+	if imageCacheHostPathOk {
+		bc.imageCacheHostPath = imageCacheHostPath
+	}
+
+	inImagePullPrewarm, inImagePullPrewarmOk := inMap["imagePullPrewarm"]
+	if inImagePullPrewarmOk {
+		if strings.EqualFold(inImagePullPrewarm, clabernetesconstants.True) {
+			bc.imagePullPrewarm = true
+		}
+	}
+
 	naming, namingOk := inMap["naming"]
 	if namingOk {
 		bc.naming = naming
@@ -302,6 +316,10 @@ func mergeFromBootstrapConfigMerge( //nolint:gocyclo
 		config.Spec.ImagePull.CRIKindOverride = bootstrap.criKindOverride
 	}
 
+	if config.Spec.ImagePull.ImageCacheHostPath == "" {
+		config.Spec.ImagePull.ImageCacheHostPath = bootstrap.imageCacheHostPath
+	}
+
 	if config.Spec.Naming == "" {
 		config.Spec.Naming = bootstrap.naming
 	}
@@ -329,6 +347,8 @@ func mergeFromBootstrapConfigReplace(
 			PullThroughOverride: bootstrap.imagePullThroughMode,
 			CRISockOverride:     bootstrap.criSockOverride,
 			CRIKindOverride:     bootstrap.criKindOverride,
+			ImageCacheHostPath:  bootstrap.imageCacheHostPath,
+			Prewarm:             bootstrap.imagePullPrewarm,
 		},
 		Deployment: clabernetesapisv1alpha1.ConfigDeployment{
 			ResourcesDefault:            bootstrap.resourcesDefault,
@@ -16,6 +16,7 @@ func GetFakeManager() Manager {
 // fakeManager defined type alias to be used below.
 type fakeManager struct {
 	nodeSelectorsByImage map[string]map[string]string
+	imageCacheHostPath   string
 }
 
 // FakeOption defined type alias to be used below.
@@ -46,6 +47,14 @@ func WithNodeSelectors(selectors map[string]map[string]string) FakeOption {
 	}
 }
 
+// WithImageCacheHostPath returns a fake manager with the node image cache enabled at the given
+// host path.
+func WithImageCacheHostPath(path string) FakeOption {
+	return func(fm *fakeManager) {
+		fm.imageCacheHostPath = path
+	}
+}
+
 func (f fakeManager) Start() error {
 	return nil
 }
@@ -109,6 +118,14 @@ func (f fakeManager) GetImagePullCriKindOverride() string {
 	return ""
 }
 
+func (f fakeManager) GetImagePullPrewarm() bool {
+	return false
+}
+
+func (f fakeManager) GetImagePullImageCacheHostPath() string {
+	return f.imageCacheHostPath
+}
+
 func (f fakeManager) GetDockerDaemonConfig() string {
 	return ""
 }
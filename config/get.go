@@ -117,6 +117,20 @@ func (m *manager) GetImagePullCriKindOverride() string {
 	return m.config.ImagePull.CRIKindOverride
 }
 
+func (m *manager) GetImagePullPrewarm() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.config.ImagePull.Prewarm
+}
+
+func (m *manager) GetImagePullImageCacheHostPath() string {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.config.ImagePull.ImageCacheHostPath
+}
+
 func (m *manager) GetDockerDaemonConfig() string {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
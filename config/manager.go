@@ -138,6 +138,11 @@ type Manager interface { //nolint: interfacebloat
 	GetImagePullCriSockOverride() string
 	// GetImagePullCriKindOverride returns the cri kind override.
 	GetImagePullCriKindOverride() string
+	// GetImagePullPrewarm returns the global config value for image prewarming.
+	GetImagePullPrewarm() bool
+	// GetImagePullImageCacheHostPath returns the host path of the node image cache, an empty
+	// string means the node image cache is disabled.
+	GetImagePullImageCacheHostPath() string
 	// GetDockerDaemonConfig returns the secret name to mount in /etc/docker -- the secret *must*
 	// have a key "daemon.json" so the final mounted file is /etc/docker/daemon.json.
 	GetDockerDaemonConfig() string
@@ -28,6 +28,12 @@ const (
 	// SlurpeethServicePort is the port number for slurpeeth that we use in the kubernetes service.
 	SlurpeethServicePort = 4799
 
+	// GENEVEServicePort is the port number for geneve that we use in the kubernetes service.
+	GENEVEServicePort = 16081
+
+	// WireGuardServicePort is the port number for wireguard that we use in the kubernetes service.
+	WireGuardServicePort = 61820
+
 	// TCP is... TCP.
 	TCP = "TCP"
 
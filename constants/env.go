@@ -57,6 +57,10 @@ const (
 	// persistence of clabernetes when invoked on the launcher pod.
 	LauncherContainerlabPersist = "LAUNCHER_CONTAINERLAB_PERSIST"
 
+	// LauncherContainerlabSaveOnTeardown is the environment variable name that can be used to
+	// have the launcher run containerlab save before destroying the lab on shutdown.
+	LauncherContainerlabSaveOnTeardown = "LAUNCHER_CONTAINERLAB_SAVE_ON_TEARDOWN"
+
 	// LauncherImageEnv env var that tells the controllers what image to use for clabernetes
 	// (launcher) pods.
 	LauncherImageEnv = "LAUNCHER_IMAGE"
@@ -77,6 +81,10 @@ const (
 	// LauncherCRIKindEnv env var tells the launcher what CRI sock is mounted in it (if configured).
 	LauncherCRIKindEnv = "LAUNCHER_CRI_KIND"
 
+	// LauncherImageCacheEnv env var that, when set to "true", tells the launcher to share node
+	// images exported from the CRI with other launchers via the node image cache.
+	LauncherImageCacheEnv = "LAUNCHER_IMAGE_CACHE"
+
 	// LauncherTopologyNameEnv is the env var that holds the name of the topology that a given
 	// launcher is responsible for.
 	LauncherTopologyNameEnv = "LAUNCHER_TOPOLOGY_NAME"
@@ -89,6 +97,18 @@ const (
 	// topology that a given launcher is responsible for.
 	LauncherNodeImageEnv = "LAUNCHER_NODE_IMAGE"
 
+	// LauncherSegmentEnv is the env var that, when set to "true", tells the launcher that its node
+	// is a LAN segment (bridge) rather than a containerized node.
+	LauncherSegmentEnv = "LAUNCHER_SEGMENT"
+
+	// LauncherRuntimeEnv is the env var that holds the container runtime the launcher should use,
+	// unset means docker.
+	LauncherRuntimeEnv = "LAUNCHER_RUNTIME"
+
+	// LauncherWaitForNodesEnv is the env var that holds the comma separated (primary) nodes of
+	// other launchers that must be ready before the launcher deploys its sub-topology.
+	LauncherWaitForNodesEnv = "LAUNCHER_WAIT_FOR_NODES"
+
 	// LauncherConnectivityKind is the env var that holds the flavor cf connectivity the launcher
 	// should run (vxlan/slurpeeth).
 	LauncherConnectivityKind = "LAUNCHER_CONNECTIVITY_KIND"
@@ -112,6 +132,85 @@ const (
 	// LauncherSSHProbePassword is the env var that holds the password to use in the ssh probe (if
 	// configured).
 	LauncherSSHProbePassword = "LAUNCHER_SSH_PROBE_PASSWORD" //nolint:gosec
+
+	// LauncherSSHProbePrivateKey is the env var that holds the (pem encoded) private key to use in
+	// the ssh probe (if configured).
+	LauncherSSHProbePrivateKey = "LAUNCHER_SSH_PROBE_PRIVATE_KEY" //nolint:gosec
+
+	// LauncherHTTPProbePort is the env var that holds the port to use in the http probe (if
+	// configured).
+	LauncherHTTPProbePort = "LAUNCHER_HTTP_PROBE_PORT"
+
+	// LauncherHTTPProbePath is the env var that holds the path to request in the http probe (if
+	// configured).
+	LauncherHTTPProbePath = "LAUNCHER_HTTP_PROBE_PATH"
+
+	// LauncherHTTPProbeScheme is the env var that holds the scheme (HTTP/HTTPS) to use in the http
+	// probe (if configured).
+	LauncherHTTPProbeScheme = "LAUNCHER_HTTP_PROBE_SCHEME"
+
+	// LauncherHTTPProbeExpectedStatusCode is the env var that holds the status code the http probe
+	// expects (if configured).
+	LauncherHTTPProbeExpectedStatusCode = "LAUNCHER_HTTP_PROBE_EXPECTED_STATUS_CODE"
+
+	// LauncherGNMIProbePort is the env var that holds the port to use in the gnmi probe (if
+	// configured).
+	LauncherGNMIProbePort = "LAUNCHER_GNMI_PROBE_PORT"
+
+	// LauncherGNMIProbeUsername is the env var that holds the username to use in the gnmi probe
+	// (if configured).
+	LauncherGNMIProbeUsername = "LAUNCHER_GNMI_PROBE_USERNAME"
+
+	// LauncherGNMIProbePassword is the env var that holds the password to use in the gnmi probe
+	// (if configured).
+	LauncherGNMIProbePassword = "LAUNCHER_GNMI_PROBE_PASSWORD" //nolint:gosec
+
+	// LauncherGNMIProbeInsecure is the env var that, when set to "true", makes the gnmi probe use
+	// a plaintext connection.
+	LauncherGNMIProbeInsecure = "LAUNCHER_GNMI_PROBE_INSECURE"
+
+	// LauncherNETCONFProbePort is the env var that holds the port to use in the netconf probe (if
+	// configured).
+	LauncherNETCONFProbePort = "LAUNCHER_NETCONF_PROBE_PORT"
+
+	// LauncherNETCONFProbeUsername is the env var that holds the username to use in the netconf
+	// probe (if configured).
+	LauncherNETCONFProbeUsername = "LAUNCHER_NETCONF_PROBE_USERNAME"
+
+	// LauncherNETCONFProbePassword is the env var that holds the password to use in the netconf
+	// probe (if configured).
+	LauncherNETCONFProbePassword = "LAUNCHER_NETCONF_PROBE_PASSWORD" //nolint:gosec
+
+	// LauncherExecProbeCommand is the env var that holds the (json encoded) command to execute in
+	// the node container for the exec probe (if configured).
+	LauncherExecProbeCommand = "LAUNCHER_EXEC_PROBE_COMMAND"
+
+	// LauncherExecProbeExpectedOutput is the env var that holds the regular expression the output
+	// of the exec probe command must match (if configured).
+	LauncherExecProbeExpectedOutput = "LAUNCHER_EXEC_PROBE_EXPECTED_OUTPUT"
+
+	// LauncherProbeInterval is the env var that holds the interval (in seconds) at which the
+	// launcher runs the status probe(s) (if configured).
+	LauncherProbeInterval = "LAUNCHER_PROBE_INTERVAL"
+
+	// LauncherProbeTimeout is the env var that holds the timeout (in seconds) of a single status
+	// probe attempt (if configured).
+	LauncherProbeTimeout = "LAUNCHER_PROBE_TIMEOUT"
+
+	// LauncherLivenessFailureThreshold is the env var that holds the number of consecutive failed
+	// status probe checks after which the launcher applies the liveness restart policy (if
+	// configured).
+	LauncherLivenessFailureThreshold = "LAUNCHER_LIVENESS_FAILURE_THRESHOLD"
+
+	// LauncherLivenessRestartPolicy is the env var that holds the liveness restart policy the
+	// launcher applies (if configured) -- only set for the policies handled by the launcher itself
+	// (never/node).
+	LauncherLivenessRestartPolicy = "LAUNCHER_LIVENESS_RESTART_POLICY"
+
+	// LauncherMetricsEnv is the env var that, when set to "true", enables the launcher prometheus
+	// metrics endpoint (served on the MetricsPort). This can be set via the extra env settings in
+	// the global config or on a Topology.
+	LauncherMetricsEnv = "LAUNCHER_METRICS"
 )
 
 const (
@@ -0,0 +1,81 @@
+package constants
+
+const (
+	// EventReasonTopologyStateChanged is the event reason used when a Topology transitions from
+	// one topology state to another, for example from "deploying" to "running".
+	EventReasonTopologyStateChanged = "TopologyStateChanged"
+
+	// EventReasonNodeStatusChanged is the event reason used when the readiness status of a node
+	// in a Topology changes.
+	EventReasonNodeStatusChanged = "NodeStatusChanged"
+
+	// EventReasonNodeRestarted is the event reason used when clabernetes restarts a node (its
+	// launcher deployment) due to a configuration change.
+	EventReasonNodeRestarted = "NodeRestarted"
+
+	// EventReasonNodeRestartFailed is the event reason used when clabernetes failed to restart a
+	// node (its launcher deployment) after a configuration change.
+	EventReasonNodeRestartFailed = "NodeRestartFailed"
+
+	// EventReasonTunnelsAllocated is the event reason used when new tunnel ids are allocated for
+	// the point-to-point tunnels of a Topology.
+	EventReasonTunnelsAllocated = "TunnelsAllocated"
+
+	// EventReasonDefinitionInvalid is the event reason used when the definition of a Topology
+	// could not be parsed or processed.
+	EventReasonDefinitionInvalid = "DefinitionInvalid"
+
+	// EventReasonTopologyTeardown is the event reason used when clabernetes starts gracefully
+	// tearing down the launchers of a deleted Topology.
+	EventReasonTopologyTeardown = "TopologyTeardown"
+
+	// EventReasonTopologyTeardownTimeout is the event reason used when the launchers of a deleted
+	// Topology did not finish tearing down within the teardown timeout.
+	EventReasonTopologyTeardownTimeout = "TopologyTeardownTimeout"
+
+	// EventReasonSnapshotStarted is the event reason used when clabernetes starts capturing the
+	// node configurations for a TopologySnapshot.
+	EventReasonSnapshotStarted = "SnapshotStarted"
+
+	// EventReasonSnapshotNodeFailed is the event reason used when capturing the configuration of a
+	// single node for a TopologySnapshot failed.
+	EventReasonSnapshotNodeFailed = "SnapshotNodeFailed"
+
+	// EventReasonSnapshotCompleted is the event reason used when the configuration of every node
+	// for a TopologySnapshot was captured successfully.
+	EventReasonSnapshotCompleted = "SnapshotCompleted"
+
+	// EventReasonSnapshotFailed is the event reason used when a TopologySnapshot could not be
+	// started or one or more of its nodes failed to be captured.
+	EventReasonSnapshotFailed = "SnapshotFailed"
+
+	// EventReasonSnapshotRestoreFailed is the event reason used when the node configurations of a
+	// Topology could not be restored from the TopologySnapshot set in its spec.
+	EventReasonSnapshotRestoreFailed = "SnapshotRestoreFailed"
+)
+
+const (
+	// EventActionReconcile is the event action for things that happen during (or as a result of)
+	// the normal reconciliation of a resource.
+	EventActionReconcile = "Reconcile"
+
+	// EventActionRestart is the event action for node (launcher deployment) restarts.
+	EventActionRestart = "Restart"
+
+	// EventActionAllocate is the event action for tunnel id allocation.
+	EventActionAllocate = "Allocate"
+
+	// EventActionProcessDefinition is the event action for processing a Topology definition.
+	EventActionProcessDefinition = "ProcessDefinition"
+
+	// EventActionTeardown is the event action for the graceful teardown of a deleted Topology.
+	EventActionTeardown = "Teardown"
+
+	// EventActionSnapshot is the event action for capturing node configurations for a
+	// TopologySnapshot.
+	EventActionSnapshot = "Snapshot"
+
+	// EventActionRestore is the event action for restoring node configurations of a Topology from
+	// a TopologySnapshot.
+	EventActionRestore = "Restore"
+)
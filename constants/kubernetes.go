@@ -12,6 +12,15 @@ const (
 
 	// KubernetesDeployment is a const to use for "deployment".
 	KubernetesDeployment = "deployment"
+
+	// KubernetesSecret is a const to use for "secret".
+	KubernetesSecret = "secret"
+
+	// KubernetesImageRequest is a const to use for "imagerequest".
+	KubernetesImageRequest = "imagerequest"
+
+	// KubernetesEndpointSlice is a const to use for "endpointslice".
+	KubernetesEndpointSlice = "endpointslice"
 )
 
 const (
@@ -31,6 +40,9 @@ const (
 	KubernetesCRIContainerd = "containerd"
 	// KubernetesCRICrio is a const for the "cri-o" type of CRI in a cluster.
 	KubernetesCRICrio = "crio"
+	// KubernetesCRIDocker is a const for the "docker" (dockershim/cri-dockerd) type of CRI in a
+	// cluster.
+	KubernetesCRIDocker = "docker"
 )
 
 const (
@@ -38,15 +50,67 @@ const (
 	KubernetesCRISockContainerdPath = "/run/containerd"
 	// KubernetesCRISockContainerd is the containerd sock filename.
 	KubernetesCRISockContainerd = "containerd.sock"
+	// KubernetesCRIStorageCrioPath is the path where the cri-o (containers/storage) image storage
+	// directory lives -- cri-o has no api to export images, so we read its storage directly.
+	KubernetesCRIStorageCrioPath = "/var/lib/containers"
+	// KubernetesCRIStorageCrio is the cri-o (containers/storage) image storage directory name.
+	KubernetesCRIStorageCrio = "storage"
+	// KubernetesCRISockDockerPath is the path where the docker sock lives.
+	KubernetesCRISockDockerPath = "/var/run"
+	// KubernetesCRISockDocker is the docker sock filename.
+	KubernetesCRISockDocker = "docker.sock"
 )
 
 const (
 	// LauncherCRISockPath is the path where, if configured, the CRI sock is mounted in launcher
 	// pods.
 	LauncherCRISockPath = "/clabernetes/.node"
+	// LauncherImageCachePath is the path where, if configured, the node image cache host path is
+	// mounted in launcher pods.
+	LauncherImageCachePath = "/clabernetes/.image-cache"
 )
 
 const (
 	// TopologyReadyStatus a const for the ready status, for consistency.
 	TopologyReadyStatus = "TopologyReady"
+
+	// TopologyDataplaneReadyStatus a const for the dataplane ready condition that summarises the
+	// state of the tunnels between the launchers of a topology.
+	TopologyDataplaneReadyStatus = "DataplaneReady"
+
+	// DataplaneReasonTunnelsUp is the dataplane condition reason when all tunnels are up.
+	DataplaneReasonTunnelsUp = "TunnelsUp"
+
+	// DataplaneReasonTunnelsDown is the dataplane condition reason when one or more tunnels are
+	// not up.
+	DataplaneReasonTunnelsDown = "TunnelsDown"
+
+	// DataplaneReasonTunnelsUnknown is the dataplane condition reason when one or more tunnels
+	// have not (yet) been reported by the launchers.
+	DataplaneReasonTunnelsUnknown = "TunnelsUnknown"
+)
+
+const (
+	// ImageRequestPulledCondition is the image request condition that reflects the state of the
+	// image pull on the requested node.
+	ImageRequestPulledCondition = "Pulled"
+
+	// ImageRequestReasonPulling is the pulled condition reason while pull attempts are (still) in
+	// progress.
+	ImageRequestReasonPulling = "Pulling"
+
+	// ImageRequestReasonPulled is the pulled condition reason once the image has been pulled.
+	ImageRequestReasonPulled = "Pulled"
+
+	// ImageRequestReasonPullTimeout is the pulled condition reason when a puller pod neither
+	// pulled the image nor reported a pull failure in time. Other failures use the reason the
+	// kubelet reported for the puller pod container (i.e. "ErrImagePull").
+	ImageRequestReasonPullTimeout = "PullTimeout"
+)
+
+const (
+	// TopologyTeardownFinalizer is the finalizer clabernetes places on Topology resources so that
+	// launchers can be gracefully torn down (containerlab destroy and friends) before the Topology
+	// and its owned resources are removed.
+	TopologyTeardownFinalizer = "clabernetes.containerlab.dev/teardown"
 )
@@ -40,6 +40,11 @@ const (
 	// type -- this indicates that this service is of the type that is used for exposing ports on
 	// a containerlab node via a LoadBalancer service.
 	TopologyServiceTypeExpose = "expose"
+	// TopologyServiceTypeSharedExpose is one of the allowed values for the LabelTopologyServiceType
+	// label type -- this indicates that this service is the single LoadBalancer service exposing
+	// the ports of all nodes of a topology (the "SharedLoadBalancer" expose type). The endpoint
+	// slices backing that service carry this label value too.
+	TopologyServiceTypeSharedExpose = "sharedExpose"
 )
 
 const (
@@ -70,3 +75,20 @@ const (
 	// puller pod.
 	LabelPullerNodeTarget = "clabernetes/pullerNodeTarget"
 )
+
+const (
+	// LabelTopologySnapshot is the label indicating the TopologySnapshot that owns the given
+	// resource (i.e. a node configuration ConfigMap).
+	LabelTopologySnapshot = "clabernetes/topologySnapshot"
+)
+
+const (
+	// AnnotationNodeLiveness is the annotation the launcher sets on its own pod to report the
+	// liveness status ("passing"/"failing") of the node when the liveness checks are handled by
+	// the launcher rather than a k8s liveness probe.
+	AnnotationNodeLiveness = "clabernetes/nodeLiveness"
+
+	// AnnotationNodeRestarts is the annotation the launcher sets on its own pod to report how
+	// often it restarted the node container due to failing liveness checks.
+	AnnotationNodeRestarts = "clabernetes/nodeRestarts"
+)
@@ -28,9 +28,41 @@ const (
 	// ConnectivityVXLAN is a constant for the vxlan connectivity flavor.
 	ConnectivityVXLAN = "vxlan"
 
+	// ConnectivityVXLANNetlink is a constant for the vxlan connectivity flavor that manages the
+	// vxlan tunnels in-process via netlink rather than via containerlab tools.
+	ConnectivityVXLANNetlink = "vxlan-netlink"
+
 	// ConnectivitySlurpeeth is a constant for the slurpeeth connectivity flavor.
 	ConnectivitySlurpeeth = "slurpeeth"
 
+	// ConnectivityGENEVE is a constant for the geneve connectivity flavor.
+	ConnectivityGENEVE = "geneve"
+
+	// ConnectivityWireGuard is a constant for the wireguard connectivity flavor -- vxlan tunnels
+	// carried through encrypted wireguard interfaces between the launchers.
+	ConnectivityWireGuard = "wireguard"
+
+	// WireGuardNetwork is the network the addresses of the wireguard interfaces of the launchers
+	// of a topology are allocated from.
+	WireGuardNetwork = "198.18.0.0/16"
+
+	// WireGuardPrivateKeyPath is the path the wireguard private key of a launcher is mounted at.
+	WireGuardPrivateKeyPath = "/clabernetes/wireguard/private.key"
+
+	// ContainerlabKindBridge is the containerlab kind for linux bridge nodes -- in clabernetes
+	// these nodes are LAN (multi-access) segments that span launchers.
+	ContainerlabKindBridge = "bridge"
+
+	// ContainerlabKindOVSBridge is the containerlab kind for openvswitch bridge nodes -- the
+	// launcher has no openvswitch, so these are treated exactly like "bridge" nodes.
+	ContainerlabKindOVSBridge = "ovs-bridge"
+
+	// LinkStateUp is a constant for the "up" administrative state of a link/tunnel.
+	LinkStateUp = "up"
+
+	// LinkStateDown is a constant for the "down" administrative state of a link/tunnel.
+	LinkStateDown = "down"
+
 	// NodeStatusFile is the file we write the node status to for launchers -- this is also used
 	// by the deployment for startup/liveness probes.
 	NodeStatusFile = "/clabernetes/.nodestatus"
@@ -47,6 +79,18 @@ const (
 	// deployments running but do not report ready (via startup/readiness probes).
 	NodeStatusNotReady = "notready"
 
+	// ImagePrewarmStatePulling is reported in the topology.status.imageprewarm map while an image
+	// is being pre-pulled onto a kubernetes node.
+	ImagePrewarmStatePulling = "pulling"
+
+	// ImagePrewarmStatePulled is reported in the topology.status.imageprewarm map once an image
+	// has been pre-pulled onto a kubernetes node.
+	ImagePrewarmStatePulled = "pulled"
+
+	// ImagePrewarmStateFailed is reported in the topology.status.imageprewarm map if pre-pulling an
+	// image onto a kubernetes node failed.
+	ImagePrewarmStateFailed = "failed"
+
 	// NodeStatusUnknown is reported in the topology.status.nodereadiness map for nodes that have
 	// no deployment available for whatever reason.
 	NodeStatusUnknown = "unknown"
@@ -54,4 +98,24 @@ const (
 	// NodeStatusDeploymentDisabled is reported in the topology.status.nodereadiness map when the
 	// parent topology has the "clabernetes/disableDeployments" label set.
 	NodeStatusDeploymentDisabled = "deploymentDisabled"
+
+	// LivenessRestartPolicyNever is the liveness restart policy that only reports the node
+	// liveness status, the node is never restarted.
+	LivenessRestartPolicyNever = "never"
+
+	// LivenessRestartPolicyNode is the liveness restart policy where the launcher redeploys its
+	// containerlab node(s) when the node fails its liveness checks.
+	LivenessRestartPolicyNode = "node"
+
+	// LivenessRestartPolicyLauncher is the (default) liveness restart policy where a k8s liveness
+	// probe restarts the launcher when the node fails its liveness checks.
+	LivenessRestartPolicyLauncher = "launcher"
+
+	// LauncherRuntimeDocker is the (default) launcher runtime where the launcher runs a nested
+	// docker daemon.
+	LauncherRuntimeDocker = "docker"
+
+	// LauncherRuntimePodman is the launcher runtime where the launcher uses containerlab's podman
+	// runtime rather than a nested docker daemon.
+	LauncherRuntimePodman = "podman"
 )
@@ -43,6 +43,15 @@ const (
 	// PortGNMINokia is the Nokia default GNMI port number.
 	PortGNMINokia = 57400
 
+	// MetricsPort is the port number the clabernetes manager (and, if enabled, launcher) serve
+	// prometheus metrics on.
+	MetricsPort = 10080
+
 	// HealthProbePort is the port number for kubernetes health endpoints to run on.
 	HealthProbePort = 8080
 )
+
+const (
+	// MetricsPortName is the name of the metrics port on the launcher container.
+	MetricsPortName = "metrics"
+)
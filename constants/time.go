@@ -11,4 +11,43 @@ const (
 	// where we handle puller pod requests and in the launcher when we wait for the image to be
 	// available.
 	PullerPodTimeout = 5 * time.Minute
+
+	// ImageRequestRetryBackoff is the backoff before the first retry of a failed image pull, the
+	// backoff doubles with each further failed attempt.
+	ImageRequestRetryBackoff = 10 * time.Second
+
+	// ImageRequestMaxPullAttempts is the max number of times the ImageRequest controller attempts
+	// to pull an image (spawns a puller pod) before giving up on the image request.
+	ImageRequestMaxPullAttempts = 3
+
+	// ImageRequestPullTimeout is the longest an accepted ImageRequest may take to complete --
+	// every pull attempt timing out plus the backoff between the attempts. This is how long the
+	// launcher waits for its image to show up on the node.
+	ImageRequestPullTimeout = ImageRequestMaxPullAttempts*PullerPodTimeout +
+		(1<<(ImageRequestMaxPullAttempts-1)-1)*ImageRequestRetryBackoff
+
+	// ImageRequestTTL is how long a completed (or failed) ImageRequest is kept before it is
+	// deleted, this gives the requesting launchers time to read the outcome of the request.
+	ImageRequestTTL = 10 * time.Minute
+
+	// TopologyTeardownTimeout is the max time we wait for the launchers of a deleted Topology to
+	// gracefully tear down before removing the teardown finalizer regardless.
+	TopologyTeardownTimeout = 5 * time.Minute
+
+	// TopologyTeardownRequeueInterval is the interval at which a deleted Topology is re-checked
+	// while waiting for its launchers to gracefully tear down.
+	TopologyTeardownRequeueInterval = 5 * time.Second
+
+	// LauncherTerminationGracePeriodSeconds is the termination grace period set on launcher pods,
+	// this gives the launcher time to save/destroy the containerlab topology on shutdown.
+	LauncherTerminationGracePeriodSeconds = 120
+
+	// LauncherTeardownTimeout is the max time the launcher spends tearing down (saving and
+	// destroying) the containerlab topology on shutdown -- this must be less than the
+	// LauncherTerminationGracePeriodSeconds.
+	LauncherTeardownTimeout = 90 * time.Second
+
+	// TopologySnapshotNodeTimeout is the max time we wait for a single node of a Topology to
+	// save and return its configuration when processing a TopologySnapshot.
+	TopologySnapshotNodeTimeout = 2 * time.Minute
 )
@@ -0,0 +1,14 @@
+package constants
+
+const (
+	// ValidateTopologyWebhookPath is the http path the clabernetes manager serves the topology
+	// validating admission webhook on.
+	ValidateTopologyWebhookPath = "/validate/topology"
+
+	// MutateTopologyWebhookPath is the http path the clabernetes manager serves the topology
+	// mutating (defaulting) admission webhook on.
+	MutateTopologyWebhookPath = "/mutate/topology"
+
+	// WebhookTimeoutSeconds is the timeout set on clabernetes admission webhook configurations.
+	WebhookTimeoutSeconds = 5
+)
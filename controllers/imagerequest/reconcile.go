@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"strconv"
+	"time"
 
 	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
 	clabernetesconfig "github.com/srl-labs/clabernetes/config"
 	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetesmetrics "github.com/srl-labs/clabernetes/metrics"
 	clabernetesutil "github.com/srl-labs/clabernetes/util"
 	clabernetesutilkubernetes "github.com/srl-labs/clabernetes/util/kubernetes"
 	k8scorev1 "k8s.io/api/core/v1"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachinerymeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachinerywatch "k8s.io/apimachinery/pkg/watch"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
@@ -46,10 +50,24 @@ func (c *Controller) Reconcile(
 		return ctrlruntime.Result{}, nil
 	}
 
+	if imageRequest.Status.CompletionTime != nil {
+		return c.reconcileCompleted(ctx, imageRequest)
+	}
+
 	if !imageRequest.Status.Accepted {
 		// set "accepted" so the launcher knows that the controller has seen the request
 		imageRequest.Status.Accepted = true
 
+		apimachinerymeta.SetStatusCondition(
+			&imageRequest.Status.Conditions,
+			metav1.Condition{
+				Type:    clabernetesconstants.ImageRequestPulledCondition,
+				Status:  metav1.ConditionUnknown,
+				Reason:  clabernetesconstants.ImageRequestReasonPulling,
+				Message: "image request accepted, pulling image",
+			},
+		)
+
 		err = c.update(ctx, imageRequest)
 		if err != nil {
 			return ctrlruntime.Result{}, err
@@ -59,37 +77,185 @@ func (c *Controller) Reconcile(
 		return ctrlruntime.Result{Requeue: true}, nil
 	}
 
-	pullerPodName, err := c.spawnImagePullerPod(ctx, imageRequest)
+	if imageRequest.Status.NextAttemptTime != nil {
+		// status updates trigger reconciles too, so make sure to wait out the backoff of the
+		// failed attempt before attempting again
+		remainingBackoff := time.Until(imageRequest.Status.NextAttemptTime.Time)
+		if remainingBackoff > 0 {
+			return ctrlruntime.Result{RequeueAfter: remainingBackoff}, nil
+		}
+	}
+
+	err = c.pullImage(ctx, imageRequest)
 	if err != nil {
 		return ctrlruntime.Result{}, err
 	}
 
-	err = c.waitImagePullerPodOutOfPending(ctx, imageRequest.Namespace, pullerPodName)
-	if err != nil {
+	if imageRequest.Status.CompletionTime == nil {
+		// the attempt failed but is going to be retried once the backoff expired
+		return ctrlruntime.Result{
+			RequeueAfter: time.Until(imageRequest.Status.NextAttemptTime.Time),
+		}, nil
+	}
+
+	// keep the cr around for a bit so launchers can see how things went, it is deleted once the
+	// ttl expired
+	return ctrlruntime.Result{RequeueAfter: clabernetesconstants.ImageRequestTTL}, nil
+}
+
+// reconcileCompleted handles image requests that are completed (successfully or not), deleting
+// them once their ttl has expired.
+func (c *Controller) reconcileCompleted(
+	ctx context.Context,
+	imageRequest *clabernetesapisv1alpha1.ImageRequest,
+) (ctrlruntime.Result, error) {
+	remainingTTL := clabernetesconstants.ImageRequestTTL -
+		time.Since(imageRequest.Status.CompletionTime.Time)
+	if remainingTTL > 0 {
+		return ctrlruntime.Result{RequeueAfter: remainingTTL}, nil
+	}
+
+	err := c.delete(ctx, imageRequest)
+	if err != nil && !apimachineryerrors.IsNotFound(err) {
 		return ctrlruntime.Result{}, err
 	}
 
+	return ctrlruntime.Result{}, nil
+}
+
+// pullImage runs a single puller pod attempt for the image request and records the outcome in the
+// image request status. The status CompletionTime is set once the image was pulled or once we
+// should not retry any more, otherwise NextAttemptTime is set to when the next attempt is due.
+func (c *Controller) pullImage(
+	ctx context.Context,
+	imageRequest *clabernetesapisv1alpha1.ImageRequest,
+) error {
+	pullStartTime := time.Now()
+
+	pullerPodName, err := c.spawnImagePullerPod(ctx, imageRequest)
+	if err != nil {
+		return err
+	}
+
+	pulled, failureReason, failureMessage, err := c.waitImagePullerPodOutOfPending(
+		ctx,
+		imageRequest.Namespace,
+		pullerPodName,
+	)
+	if err != nil {
+		return err
+	}
+
+	pullResult := clabernetesmetrics.ImagePullResultIncomplete
+
+	switch {
+	case pulled:
+		pullResult = clabernetesmetrics.ImagePullResultSucceeded
+	case failureReason != "":
+		pullResult = clabernetesmetrics.ImagePullResultFailed
+	}
+
+	clabernetesmetrics.RecordImagePull(time.Since(pullStartTime), pullResult)
+
 	err = c.deleteImagePullerPod(ctx, imageRequest.Namespace, pullerPodName)
 	if err != nil {
-		return ctrlruntime.Result{}, err
+		return err
 	}
 
-	if !imageRequest.Status.Complete {
+	imageRequest.Status.Attempts++
+	imageRequest.Status.NextAttemptTime = nil
+
+	if pulled {
 		imageRequest.Status.Complete = true
+		imageRequest.Status.FailureReason = ""
+		imageRequest.Status.FailureMessage = ""
+		imageRequest.Status.CompletionTime = clabernetesutil.ToPointer(metav1.Now())
+
+		apimachinerymeta.SetStatusCondition(
+			&imageRequest.Status.Conditions,
+			metav1.Condition{
+				Type:   clabernetesconstants.ImageRequestPulledCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clabernetesconstants.ImageRequestReasonPulled,
+				Message: fmt.Sprintf(
+					"image pulled on node %q",
+					imageRequest.Spec.KubernetesNode,
+				),
+			},
+		)
 
-		err = c.update(ctx, imageRequest)
-		if err != nil {
-			return ctrlruntime.Result{}, err
-		}
+		return c.update(ctx, imageRequest)
 	}
 
-	// we've done the job of the puller pod, delete the cr
-	err = c.delete(ctx, imageRequest)
-	if err != nil {
-		return ctrlruntime.Result{}, err
+	if failureReason == "" {
+		failureReason = clabernetesconstants.ImageRequestReasonPullTimeout
+		failureMessage = "puller pod did not leave pending state in time"
 	}
 
-	return ctrlruntime.Result{}, nil
+	imageRequest.Status.FailureReason = failureReason
+	imageRequest.Status.FailureMessage = failureMessage
+
+	if imageRequest.Status.Attempts < clabernetesconstants.ImageRequestMaxPullAttempts &&
+		retryablePullFailure(failureReason) {
+		backoff := clabernetesconstants.ImageRequestRetryBackoff <<
+			(imageRequest.Status.Attempts - 1)
+
+		imageRequest.Status.NextAttemptTime = clabernetesutil.ToPointer(
+			metav1.NewTime(time.Now().Add(backoff)),
+		)
+
+		c.Log.Infof(
+			"image pull attempt %d for image %q on node %q failed, retrying in %s",
+			imageRequest.Status.Attempts,
+			imageRequest.Spec.RequestedImage,
+			imageRequest.Spec.KubernetesNode,
+			backoff,
+		)
+
+		apimachinerymeta.SetStatusCondition(
+			&imageRequest.Status.Conditions,
+			metav1.Condition{
+				Type:   clabernetesconstants.ImageRequestPulledCondition,
+				Status: metav1.ConditionUnknown,
+				Reason: clabernetesconstants.ImageRequestReasonPulling,
+				Message: fmt.Sprintf(
+					"pull attempt %d of %d failed, retrying: %s",
+					imageRequest.Status.Attempts,
+					clabernetesconstants.ImageRequestMaxPullAttempts,
+					failureMessage,
+				),
+			},
+		)
+
+		return c.update(ctx, imageRequest)
+	}
+
+	imageRequest.Status.CompletionTime = clabernetesutil.ToPointer(metav1.Now())
+
+	apimachinerymeta.SetStatusCondition(
+		&imageRequest.Status.Conditions,
+		metav1.Condition{
+			Type:   clabernetesconstants.ImageRequestPulledCondition,
+			Status: metav1.ConditionFalse,
+			Reason: failureReason,
+			Message: fmt.Sprintf(
+				"image pull failed after %d attempt(s): %s",
+				imageRequest.Status.Attempts,
+				failureMessage,
+			),
+		},
+	)
+
+	c.Log.Warnf(
+		"image pull for image %q on node %q failed after %d attempt(s), reason %q: %s",
+		imageRequest.Spec.RequestedImage,
+		imageRequest.Spec.KubernetesNode,
+		imageRequest.Status.Attempts,
+		failureReason,
+		failureMessage,
+	)
+
+	return c.update(ctx, imageRequest)
 }
 
 func (c *Controller) spawnImagePullerPod(
@@ -141,6 +307,9 @@ func (c *Controller) spawnImagePullerPod(
 				puller,
 				imageRequest.Spec.KubernetesNode,
 				imageHash,
+				// each attempt gets its own pod so a retry never races the deletion of the pod
+				// of the previous attempt
+				strconv.Itoa(imageRequest.Status.Attempts+1),
 			),
 			Namespace:   imageRequest.Namespace,
 			Annotations: annotations,
@@ -176,6 +345,14 @@ func (c *Controller) spawnImagePullerPod(
 		pullerPod,
 	)
 	if err != nil {
+		if apimachineryerrors.IsAlreadyExists(err) {
+			// a previous reconcile spawned the pod but did not get to clean it up (controller
+			// restart or similar), just pick up watching it
+			c.Log.Debugf("puller pod %q already exists, reusing it", pullerPod.Name)
+
+			return pullerPod.Name, nil
+		}
+
 		c.Log.Criticalf(
 			"failed creating image puller pod for image %q, node %q, error: %s",
 			imageRequest.Spec.RequestedImage,
@@ -189,18 +366,24 @@ func (c *Controller) spawnImagePullerPod(
 	return pullerPod.Name, nil
 }
 
+// waitImagePullerPodOutOfPending watches the puller pod until it leaves the pending state (the
+// image was pulled) or until the kubelet reports that it failed pulling the image, in the latter
+// case the failure reason and message are returned.
 func (c *Controller) waitImagePullerPodOutOfPending(
 	ctx context.Context,
 	namespace, pullerPodName string,
-) error {
+) (pulled bool, failureReason, failureMessage string, err error) {
 	listOptions := metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("metadata.name=%s", pullerPodName),
 		Watch:         true,
+		TimeoutSeconds: clabernetesutil.ToPointer(
+			int64(clabernetesconstants.PullerPodTimeout.Seconds()),
+		),
 	}
 
 	watch, err := c.KubeClient.CoreV1().Pods(namespace).Watch(ctx, listOptions)
 	if err != nil {
-		return err
+		return false, "", "", err
 	}
 
 	for event := range watch.ResultChan() {
@@ -214,7 +397,21 @@ func (c *Controller) waitImagePullerPodOutOfPending(
 			switch pod.Status.Phase { //nolint:exhaustive
 			case k8scorev1.PodPending:
 				// pending so it hasnt been scheduled, and certainly hasnt pulled the image yet...
-				continue
+				// unless of course the kubelet already told us the pull is failing
+				failureReason, failureMessage = imagePullFailure(pod)
+				if failureReason == "" {
+					continue
+				}
+
+				c.Log.Warnf(
+					"puller pod '%s/%s' failed pulling image, reason %q: %s",
+					namespace,
+					pullerPodName,
+					failureReason,
+					failureMessage,
+				)
+
+				watch.Stop()
 			case k8scorev1.PodRunning, k8scorev1.PodSucceeded, k8scorev1.PodFailed:
 				// its running/succeeded/failed any of which means the image has been pulled and
 				// we can be done/kill the pod now
@@ -224,12 +421,48 @@ func (c *Controller) waitImagePullerPodOutOfPending(
 					pullerPodName,
 				)
 
+				pulled = true
+
 				watch.Stop()
 			}
 		}
 	}
 
-	return nil
+	return pulled, failureReason, failureMessage, nil
+}
+
+// imagePullFailure returns the reason and message of a failed image pull of the given (pending)
+// puller pod, or empty strings if the kubelet did not report a pull failure (yet).
+func imagePullFailure(pod *k8scorev1.Pod) (reason, message string) {
+	for idx := range pod.Status.ContainerStatuses {
+		waiting := pod.Status.ContainerStatuses[idx].State.Waiting
+		if waiting == nil {
+			continue
+		}
+
+		switch waiting.Reason {
+		case "ErrImagePull",
+			"ImagePullBackOff",
+			"InvalidImageName",
+			"ErrImageNeverPull",
+			"RegistryUnavailable",
+			"SignatureValidationFailed":
+			return waiting.Reason, waiting.Message
+		}
+	}
+
+	return "", ""
+}
+
+// retryablePullFailure returns true if a pull that failed for the given reason may succeed when
+// tried again -- there is no point retrying an invalid image name for example.
+func retryablePullFailure(reason string) bool {
+	switch reason {
+	case "InvalidImageName", "ErrImageNeverPull":
+		return false
+	default:
+		return true
+	}
 }
 
 func (c *Controller) deleteImagePullerPod(
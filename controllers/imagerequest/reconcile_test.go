@@ -0,0 +1,110 @@
+package imagerequest //nolint:testpackage // tests cover unexported pull failure helpers
+
+import (
+	"testing"
+
+	k8scorev1 "k8s.io/api/core/v1"
+)
+
+func TestImagePullFailure(t *testing.T) {
+	cases := []struct {
+		name              string
+		containerStatuses []k8scorev1.ContainerStatus
+		expectedReason    string
+		expectedMessage   string
+		expectedRetryable bool
+	}{
+		{
+			name:              "no-status",
+			containerStatuses: nil,
+			expectedReason:    "",
+			expectedMessage:   "",
+		},
+		{
+			name: "container-creating",
+			containerStatuses: []k8scorev1.ContainerStatus{
+				{
+					State: k8scorev1.ContainerState{
+						Waiting: &k8scorev1.ContainerStateWaiting{
+							Reason: "ContainerCreating",
+						},
+					},
+				},
+			},
+			expectedReason:  "",
+			expectedMessage: "",
+		},
+		{
+			name: "err-image-pull",
+			containerStatuses: []k8scorev1.ContainerStatus{
+				{
+					State: k8scorev1.ContainerState{
+						Waiting: &k8scorev1.ContainerStateWaiting{
+							Reason:  "ErrImagePull",
+							Message: "401 Unauthorized",
+						},
+					},
+				},
+			},
+			expectedReason:    "ErrImagePull",
+			expectedMessage:   "401 Unauthorized",
+			expectedRetryable: true,
+		},
+		{
+			name: "invalid-image-name",
+			containerStatuses: []k8scorev1.ContainerStatus{
+				{
+					State: k8scorev1.ContainerState{
+						Waiting: &k8scorev1.ContainerStateWaiting{
+							Reason:  "InvalidImageName",
+							Message: "couldn't parse image name",
+						},
+					},
+				},
+			},
+			expectedReason:    "InvalidImageName",
+			expectedMessage:   "couldn't parse image name",
+			expectedRetryable: false,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				reason, message := imagePullFailure(
+					&k8scorev1.Pod{
+						Status: k8scorev1.PodStatus{
+							Phase:             k8scorev1.PodPending,
+							ContainerStatuses: testCase.containerStatuses,
+						},
+					},
+				)
+
+				if reason != testCase.expectedReason || message != testCase.expectedMessage {
+					t.Fatalf(
+						"expected reason %q message %q, got reason %q message %q",
+						testCase.expectedReason,
+						testCase.expectedMessage,
+						reason,
+						message,
+					)
+				}
+
+				if reason == "" {
+					return
+				}
+
+				if retryablePullFailure(reason) != testCase.expectedRetryable {
+					t.Fatalf(
+						"expected retryable %t for reason %q",
+						testCase.expectedRetryable,
+						reason,
+					)
+				}
+			},
+		)
+	}
+}
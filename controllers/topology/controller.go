@@ -2,6 +2,7 @@ package topology
 
 import (
 	"context"
+	"fmt"
 
 	clabernetesapis "github.com/srl-labs/clabernetes/apis"
 	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
@@ -12,10 +13,13 @@ import (
 	k8sappsv1 "k8s.io/api/apps/v1"
 	k8scorev1 "k8s.io/api/core/v1"
 	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	clientgoevents "k8s.io/client-go/tools/events"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
+	ctrlruntimebuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntimecontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	ctrlruntimehandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlruntimepredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 	ctrlruntimereconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -23,6 +27,10 @@ import (
 type Controller struct {
 	*clabernetescontrollers.BaseController
 
+	// Recorder is the event recorder used to emit kubernetes events for Topologies and the
+	// resources that belong to them.
+	Recorder clientgoevents.EventRecorder
+
 	TopologyReconciler *Reconciler
 }
 
@@ -40,11 +48,17 @@ func NewController(
 		clabernetes.GetCtrlRuntimeClient(),
 	)
 
+	recorder := clabernetes.GetCtrlRuntimeMgr().GetEventRecorder(
+		fmt.Sprintf("%s-%s-controller", clabernetes.GetAppName(), clabernetesapis.Topology),
+	)
+
 	c := &Controller{
 		BaseController: baseController,
+		Recorder:       recorder,
 		TopologyReconciler: NewReconciler(
 			baseController.Log,
 			baseController.Client,
+			recorder,
 			clabernetes.GetAppName(),
 			clabernetes.GetNamespace(),
 			clabernetes.GetClusterCRIKind(),
@@ -89,11 +103,37 @@ func (c *Controller) SetupWithManager(mgr ctrlruntime.Manager) error {
 				&clabernetesapisv1alpha1.Topology{},
 			),
 		).
+		// watch owned connectivity crs so that changes to the dataplane state the launchers report
+		// are reflected in the topology conditions -- the launchers periodically report tunnel
+		// counters, so only updates that change the dataplane state are considered
+		Watches(
+			&clabernetesapisv1alpha1.Connectivity{},
+			ctrlruntimehandler.EnqueueRequestForOwner(
+				mgr.GetScheme(),
+				mgr.GetRESTMapper(),
+				&clabernetesapisv1alpha1.Topology{},
+			),
+			ctrlruntimebuilder.WithPredicates(
+				ctrlruntimepredicate.Funcs{UpdateFunc: connectivityDataplaneChanged},
+			),
+		).
 		// watch pods so pod status changes (probe results) trigger topology reconciliation
 		Watches(
 			&k8scorev1.Pod{},
 			ctrlruntimehandler.EnqueueRequestsFromMapFunc(c.enqueueForPod),
 		).
+		// watch topology snapshots so topologies restoring from a snapshot get reconciled once
+		// the snapshot completes (or changes)
+		Watches(
+			&clabernetesapisv1alpha1.TopologySnapshot{},
+			ctrlruntimehandler.EnqueueRequestsFromMapFunc(c.enqueueForTopologySnapshot),
+		).
+		// watch image requests so topologies prewarming images get their prewarm progress updated
+		// as the image requests complete
+		Watches(
+			&clabernetesapisv1alpha1.ImageRequest{},
+			ctrlruntimehandler.EnqueueRequestsFromMapFunc(c.enqueueForImageRequest),
+		).
 		// watch our config cr too so we get any config updates handled
 		Watches(
 			&clabernetesapisv1alpha1.Config{},
@@ -124,6 +164,93 @@ func (c *Controller) enqueueForPod(
 	}}
 }
 
+// enqueueForTopologySnapshot enqueues the Topology CRs in the namespace of a TopologySnapshot that
+// restore from that TopologySnapshot.
+func (c *Controller) enqueueForTopologySnapshot(
+	ctx context.Context,
+	obj ctrlruntimeclient.Object,
+) []ctrlruntimereconcile.Request {
+	topologies := &clabernetesapisv1alpha1.TopologyList{}
+
+	err := c.Client.List(ctx, topologies, ctrlruntimeclient.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		c.Log.Criticalf(
+			"failed listing resource objects in enqueueForTopologySnapshot, err: %s",
+			err,
+		)
+
+		return nil
+	}
+
+	var requests []ctrlruntimereconcile.Request
+
+	for idx := range topologies.Items {
+		if topologies.Items[idx].Spec.Deployment.RestoreFromSnapshot != obj.GetName() {
+			continue
+		}
+
+		requests = append(
+			requests,
+			ctrlruntimereconcile.Request{
+				NamespacedName: apimachinerytypes.NamespacedName{
+					Namespace: topologies.Items[idx].GetNamespace(),
+					Name:      topologies.Items[idx].GetName(),
+				},
+			},
+		)
+	}
+
+	return requests
+}
+
+// enqueueForImageRequest enqueues the Topology CRs in the namespace of an ImageRequest that are
+// prewarming the requested image on the requested node. Image requests are shared, so this is not
+// necessarily the topology the image request was created for.
+func (c *Controller) enqueueForImageRequest(
+	ctx context.Context,
+	obj ctrlruntimeclient.Object,
+) []ctrlruntimereconcile.Request {
+	imageRequest, ok := obj.(*clabernetesapisv1alpha1.ImageRequest)
+	if !ok {
+		return nil
+	}
+
+	topologies := &clabernetesapisv1alpha1.TopologyList{}
+
+	err := c.Client.List(ctx, topologies, ctrlruntimeclient.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		c.Log.Criticalf(
+			"failed listing resource objects in enqueueForImageRequest, err: %s",
+			err,
+		)
+
+		return nil
+	}
+
+	var requests []ctrlruntimereconcile.Request
+
+	for idx := range topologies.Items {
+		imagePrewarm := topologies.Items[idx].Status.ImagePrewarm[imageRequest.Spec.RequestedImage]
+
+		prewarmState := imagePrewarm[imageRequest.Spec.KubernetesNode]
+		if prewarmState != clabernetesconstants.ImagePrewarmStatePulling {
+			continue
+		}
+
+		requests = append(
+			requests,
+			ctrlruntimereconcile.Request{
+				NamespacedName: apimachinerytypes.NamespacedName{
+					Namespace: topologies.Items[idx].GetNamespace(),
+					Name:      topologies.Items[idx].GetName(),
+				},
+			},
+		)
+	}
+
+	return requests
+}
+
 // enqueueForAll enqueues all Topology CRs for reconciliation.
 func (c *Controller) enqueueForAll(
 	ctx context.Context,
@@ -0,0 +1,164 @@
+package topology
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeevent "sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+const (
+	// operStateUp is the operational state the kernel reports for interfaces that are up.
+	operStateUp = "up"
+)
+
+// DataplaneCondition returns the dataplane condition for a topology with the given resolved
+// tunnels based on the tunnel statuses the launchers reported in the connectivity status. Tunnels
+// that are administratively down are expected to be down and are therefore ignored.
+func DataplaneCondition(
+	resolvedTunnels map[string][]*clabernetesapisv1alpha1.PointToPointTunnel,
+	connectivityStatus *clabernetesapisv1alpha1.ConnectivityStatus,
+) metav1.Condition {
+	var tunnelCount, unknownCount int
+
+	var notUpTunnels []string
+
+	for nodeName, nodeTunnels := range resolvedTunnels {
+		reportedTunnels := make(map[string]clabernetesapisv1alpha1.PointToPointTunnelStatus)
+
+		for _, tunnelStatus := range connectivityStatus.PointToPointTunnels[nodeName] {
+			reportedTunnels[tunnelStatusKey(nodeName, &tunnelStatus)] = tunnelStatus
+		}
+
+		for _, tunnel := range nodeTunnels {
+			if tunnel.State == clabernetesconstants.LinkStateDown {
+				continue
+			}
+
+			tunnelCount++
+
+			tunnelStatus, ok := reportedTunnels[fmt.Sprintf(
+				"%s/%s",
+				tunnel.LocalNode,
+				tunnel.LocalInterface,
+			)]
+			if !ok {
+				unknownCount++
+
+				continue
+			}
+
+			if !tunnelStatus.InterfaceExists || tunnelStatus.OperState != operStateUp {
+				notUpTunnels = append(
+					notUpTunnels,
+					fmt.Sprintf("%s/%s", tunnel.LocalNode, tunnel.LocalInterface),
+				)
+			}
+		}
+	}
+
+	if len(notUpTunnels) > 0 {
+		slices.Sort(notUpTunnels)
+
+		return metav1.Condition{
+			Type:   clabernetesconstants.TopologyDataplaneReadyStatus,
+			Status: metav1.ConditionFalse,
+			Reason: clabernetesconstants.DataplaneReasonTunnelsDown,
+			Message: fmt.Sprintf(
+				"%d of %d tunnel(s) not up: %s",
+				len(notUpTunnels),
+				tunnelCount,
+				strings.Join(notUpTunnels, ", "),
+			),
+		}
+	}
+
+	if unknownCount > 0 {
+		return metav1.Condition{
+			Type:   clabernetesconstants.TopologyDataplaneReadyStatus,
+			Status: metav1.ConditionUnknown,
+			Reason: clabernetesconstants.DataplaneReasonTunnelsUnknown,
+			Message: fmt.Sprintf(
+				"%d of %d tunnel(s) not reported by launchers yet",
+				unknownCount,
+				tunnelCount,
+			),
+		}
+	}
+
+	return metav1.Condition{
+		Type:    clabernetesconstants.TopologyDataplaneReadyStatus,
+		Status:  metav1.ConditionTrue,
+		Reason:  clabernetesconstants.DataplaneReasonTunnelsUp,
+		Message: fmt.Sprintf("all %d tunnel(s) up", tunnelCount),
+	}
+}
+
+// tunnelStatusKey returns the "localNode/interface" key of the given tunnel status reported by the
+// launcher of the given node. The launcher of a node group reports the tunnels of all nodes of the
+// group, so the local interface name alone does not identify a tunnel. Statuses without a local
+// node are from the launcher of a single node, so that node is the local node.
+func tunnelStatusKey(
+	nodeName string,
+	tunnelStatus *clabernetesapisv1alpha1.PointToPointTunnelStatus,
+) string {
+	localNode := tunnelStatus.LocalNode
+	if localNode == "" {
+		localNode = nodeName
+	}
+
+	return fmt.Sprintf("%s/%s", localNode, tunnelStatus.LocalInterface)
+}
+
+// dataplaneStates returns the dataplane relevant parts (existence, operational and administrative
+// state) of the given connectivity status keyed by "node/localNode/interface" -- that is, the
+// status without the ever changing counters and timestamps.
+func dataplaneStates(
+	connectivityStatus *clabernetesapisv1alpha1.ConnectivityStatus,
+) map[string]string {
+	states := make(map[string]string)
+
+	for nodeName, tunnelStatuses := range connectivityStatus.PointToPointTunnels {
+		for _, tunnelStatus := range tunnelStatuses {
+			key := fmt.Sprintf("%s/%s", nodeName, tunnelStatusKey(nodeName, &tunnelStatus))
+
+			states[key] = fmt.Sprintf(
+				"%t/%s/%s",
+				tunnelStatus.InterfaceExists,
+				tunnelStatus.OperState,
+				tunnelStatus.State,
+			)
+		}
+	}
+
+	return states
+}
+
+// connectivityDataplaneChanged is the update predicate for the connectivity watch -- launchers
+// periodically report their tunnel counters, we only want to reconcile the owning topology when
+// the state of the dataplane (or the spec) actually changed.
+func connectivityDataplaneChanged(updateEvent ctrlruntimeevent.UpdateEvent) bool {
+	oldConnectivity, ok := updateEvent.ObjectOld.(*clabernetesapisv1alpha1.Connectivity)
+	if !ok {
+		return true
+	}
+
+	newConnectivity, ok := updateEvent.ObjectNew.(*clabernetesapisv1alpha1.Connectivity)
+	if !ok {
+		return true
+	}
+
+	if oldConnectivity.GetGeneration() != newConnectivity.GetGeneration() {
+		return true
+	}
+
+	return !reflect.DeepEqual(
+		dataplaneStates(&oldConnectivity.Status),
+		dataplaneStates(&newConnectivity.Status),
+	)
+}
@@ -0,0 +1,216 @@
+package topology_test
+
+import (
+	"testing"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDataplaneCondition(t *testing.T) {
+	resolvedTunnels := map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+		"srl1": {
+			{
+				TunnelID:        1,
+				LocalNode:       "srl1",
+				LocalInterface:  "e1-1",
+				RemoteNode:      "srl2",
+				RemoteInterface: "e1-1",
+			},
+		},
+		"srl2": {
+			{
+				TunnelID:        1,
+				LocalNode:       "srl2",
+				LocalInterface:  "e1-1",
+				RemoteNode:      "srl1",
+				RemoteInterface: "e1-1",
+			},
+		},
+	}
+
+	tunnelUp := clabernetesapisv1alpha1.PointToPointTunnelStatus{
+		TunnelID:        1,
+		LocalInterface:  "e1-1",
+		State:           clabernetesconstants.LinkStateUp,
+		InterfaceExists: true,
+		OperState:       "up",
+	}
+
+	cases := []struct {
+		name               string
+		resolvedTunnels    map[string][]*clabernetesapisv1alpha1.PointToPointTunnel
+		connectivityStatus clabernetesapisv1alpha1.ConnectivityStatus
+		expectedStatus     metav1.ConditionStatus
+		expectedReason     string
+		expectedMessage    string
+	}{
+		{
+			name:            "no-tunnels",
+			resolvedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  clabernetesconstants.DataplaneReasonTunnelsUp,
+			expectedMessage: "all 0 tunnel(s) up",
+		},
+		{
+			name:            "not-reported",
+			resolvedTunnels: resolvedTunnels,
+			connectivityStatus: clabernetesapisv1alpha1.ConnectivityStatus{
+				PointToPointTunnels: map[string][]clabernetesapisv1alpha1.PointToPointTunnelStatus{
+					"srl1": {tunnelUp},
+				},
+			},
+			expectedStatus:  metav1.ConditionUnknown,
+			expectedReason:  clabernetesconstants.DataplaneReasonTunnelsUnknown,
+			expectedMessage: "1 of 2 tunnel(s) not reported by launchers yet",
+		},
+		{
+			name:            "all-up",
+			resolvedTunnels: resolvedTunnels,
+			connectivityStatus: clabernetesapisv1alpha1.ConnectivityStatus{
+				PointToPointTunnels: map[string][]clabernetesapisv1alpha1.PointToPointTunnelStatus{
+					"srl1": {tunnelUp},
+					"srl2": {tunnelUp},
+				},
+			},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  clabernetesconstants.DataplaneReasonTunnelsUp,
+			expectedMessage: "all 2 tunnel(s) up",
+		},
+		{
+			name:            "interface-down",
+			resolvedTunnels: resolvedTunnels,
+			connectivityStatus: clabernetesapisv1alpha1.ConnectivityStatus{
+				PointToPointTunnels: map[string][]clabernetesapisv1alpha1.PointToPointTunnelStatus{
+					"srl1": {tunnelUp},
+					"srl2": {
+						{
+							TunnelID:        1,
+							LocalInterface:  "e1-1",
+							State:           clabernetesconstants.LinkStateUp,
+							InterfaceExists: true,
+							OperState:       "down",
+						},
+					},
+				},
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  clabernetesconstants.DataplaneReasonTunnelsDown,
+			expectedMessage: "1 of 2 tunnel(s) not up: srl2/e1-1",
+		},
+		{
+			// the dut1 launcher hosts the co-located tgen1 as well, both use eth1
+			name: "node-groups-shared-interface",
+			resolvedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+				"dut1": {
+					{
+						TunnelID:        1,
+						LocalNode:       "dut1",
+						LocalInterface:  "eth1",
+						RemoteNode:      "dut2",
+						RemoteInterface: "e1-1",
+					},
+					{
+						TunnelID:        2,
+						LocalNode:       "tgen1",
+						LocalInterface:  "eth1",
+						RemoteNode:      "dut2",
+						RemoteInterface: "e1-2",
+					},
+				},
+			},
+			connectivityStatus: clabernetesapisv1alpha1.ConnectivityStatus{
+				PointToPointTunnels: map[string][]clabernetesapisv1alpha1.PointToPointTunnelStatus{
+					"dut1": {
+						{
+							TunnelID:        1,
+							LocalNode:       "dut1",
+							LocalInterface:  "eth1",
+							State:           clabernetesconstants.LinkStateUp,
+							InterfaceExists: true,
+							OperState:       "up",
+						},
+						{
+							TunnelID:        2,
+							LocalNode:       "tgen1",
+							LocalInterface:  "eth1",
+							State:           clabernetesconstants.LinkStateUp,
+							InterfaceExists: true,
+							OperState:       "down",
+						},
+					},
+				},
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  clabernetesconstants.DataplaneReasonTunnelsDown,
+			expectedMessage: "1 of 2 tunnel(s) not up: tgen1/eth1",
+		},
+		{
+			name: "administratively-down",
+			resolvedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+				"srl1": {
+					{
+						TunnelID:       1,
+						LocalNode:      "srl1",
+						LocalInterface: "e1-1",
+						State:          clabernetesconstants.LinkStateDown,
+					},
+				},
+			},
+			connectivityStatus: clabernetesapisv1alpha1.ConnectivityStatus{
+				PointToPointTunnels: map[string][]clabernetesapisv1alpha1.PointToPointTunnelStatus{
+					"srl1": {
+						{
+							TunnelID:        1,
+							LocalInterface:  "e1-1",
+							State:           clabernetesconstants.LinkStateDown,
+							InterfaceExists: true,
+							OperState:       "down",
+						},
+					},
+				},
+			},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  clabernetesconstants.DataplaneReasonTunnelsUp,
+			expectedMessage: "all 0 tunnel(s) up",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				actual := clabernetescontrollerstopology.DataplaneCondition(
+					testCase.resolvedTunnels,
+					&testCase.connectivityStatus,
+				)
+
+				if actual.Type != clabernetesconstants.TopologyDataplaneReadyStatus {
+					t.Fatalf(
+						"expected condition type %q, got %q",
+						clabernetesconstants.TopologyDataplaneReadyStatus,
+						actual.Type,
+					)
+				}
+
+				if actual.Status != testCase.expectedStatus {
+					t.Fatalf("expected status %q, got %q", testCase.expectedStatus, actual.Status)
+				}
+
+				if actual.Reason != testCase.expectedReason {
+					t.Fatalf("expected reason %q, got %q", testCase.expectedReason, actual.Reason)
+				}
+
+				if actual.Message != testCase.expectedMessage {
+					t.Fatalf(
+						"expected message %q, got %q", testCase.expectedMessage, actual.Message,
+					)
+				}
+			},
+		)
+	}
+}
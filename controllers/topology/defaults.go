@@ -0,0 +1,106 @@
+package topology
+
+import (
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+)
+
+// SpecDefault is a value resolved from the global config that should be set on a Topology spec
+// because the Topology did not specify a value for it.
+type SpecDefault struct {
+	// Path is the path of json field names, relative to the Topology spec, for the field.
+	Path []string
+	// Value is the value that should be set for the field.
+	Value any
+}
+
+// ResolveSpecDefaults accepts a Topology and returns the SpecDefault values for any field that is
+// unset on the Topology but has a global config counterpart -- things like the launcher image or
+// privileged launcher setting. This is used by the mutating admission webhook so that the values
+// that are actually in effect for a Topology are visible on the Topology itself.
+func ResolveSpecDefaults(
+	topology *clabernetesapisv1alpha1.Topology,
+	configManagerGetter clabernetesconfig.ManagerGetterFunc,
+) []SpecDefault {
+	configManager := configManagerGetter()
+
+	specDefaults := make([]SpecDefault, 0)
+
+	deploymentDefault := func(field string, value any) {
+		specDefaults = append(
+			specDefaults,
+			SpecDefault{
+				Path:  []string{"deployment", field},
+				Value: value,
+			},
+		)
+	}
+
+	deployment := topology.Spec.Deployment
+
+	if deployment.PrivilegedLauncher == nil {
+		deploymentDefault("privilegedLauncher", configManager.GetPrivilegedLauncher())
+	}
+
+	if deployment.ContainerlabDebug == nil {
+		deploymentDefault("containerlabDebug", configManager.GetContainerlabDebug())
+	}
+
+	stringDefaults := []struct {
+		field         string
+		topologyValue string
+		globalValue   string
+	}{
+		{
+			field:         "containerlabTimeout",
+			topologyValue: deployment.ContainerlabTimeout,
+			globalValue:   configManager.GetContainerlabTimeout(),
+		},
+		{
+			field:         "containerlabVersion",
+			topologyValue: deployment.ContainerlabVersion,
+			globalValue:   configManager.GetContainerlabVersion(),
+		},
+		{
+			field:         "launcherImage",
+			topologyValue: deployment.LauncherImage,
+			globalValue:   configManager.GetLauncherImage(),
+		},
+		{
+			field:         "launcherImagePullPolicy",
+			topologyValue: deployment.LauncherImagePullPolicy,
+			globalValue:   configManager.GetLauncherImagePullPolicy(),
+		},
+		{
+			field:         "launcherLogLevel",
+			topologyValue: deployment.LauncherLogLevel,
+			globalValue:   configManager.GetLauncherLogLevel(),
+		},
+	}
+
+	for _, stringDefault := range stringDefaults {
+		if stringDefault.topologyValue != "" || stringDefault.globalValue == "" {
+			continue
+		}
+
+		deploymentDefault(stringDefault.field, stringDefault.globalValue)
+	}
+
+	if topology.Spec.Naming == "" || topology.Spec.Naming == clabernetesconstants.NamingModeGlobal {
+		naming := clabernetesconstants.NamingModePrefixed
+		if configManager.GetRemoveTopologyPrefix() {
+			naming = clabernetesconstants.NamingModeNonPrefixed
+		}
+
+		specDefaults = append(
+			specDefaults,
+			SpecDefault{
+				Path:  []string{"naming"},
+				Value: naming,
+			},
+		)
+	}
+
+	return specDefaults
+}
@@ -0,0 +1,88 @@
+package topology_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	clabernetestesthelper "github.com/srl-labs/clabernetes/testhelper"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+)
+
+func TestResolveSpecDefaults(t *testing.T) {
+	cases := []struct {
+		name     string
+		in       *clabernetesapisv1alpha1.Topology
+		expected []clabernetescontrollerstopology.SpecDefault
+	}{
+		{
+			name: "all-unset",
+			in:   &clabernetesapisv1alpha1.Topology{},
+			expected: []clabernetescontrollerstopology.SpecDefault{
+				{
+					Path:  []string{"deployment", "privilegedLauncher"},
+					Value: true,
+				},
+				{
+					Path:  []string{"deployment", "containerlabDebug"},
+					Value: false,
+				},
+				{
+					Path:  []string{"deployment", "launcherImage"},
+					Value: "ghcr.io/srl-labs/clabernetes/clabernetes-launcher:latest",
+				},
+				{
+					Path:  []string{"deployment", "launcherImagePullPolicy"},
+					Value: "IfNotPresent",
+				},
+				{
+					Path:  []string{"deployment", "launcherLogLevel"},
+					Value: "info",
+				},
+				{
+					Path:  []string{"naming"},
+					Value: "prefixed",
+				},
+			},
+		},
+		{
+			name: "all-set",
+			in: &clabernetesapisv1alpha1.Topology{
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Deployment: clabernetesapisv1alpha1.Deployment{
+						PrivilegedLauncher:      clabernetesutil.ToPointer(false),
+						ContainerlabDebug:       clabernetesutil.ToPointer(true),
+						LauncherImage:           "some-image",
+						LauncherImagePullPolicy: "Always",
+						LauncherLogLevel:        "debug",
+					},
+					Naming: "non-prefixed",
+				},
+			},
+			expected: []clabernetescontrollerstopology.SpecDefault{},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				actual := clabernetescontrollerstopology.ResolveSpecDefaults(
+					testCase.in,
+					clabernetesconfig.GetFakeManager,
+				)
+
+				if !reflect.DeepEqual(actual, testCase.expected) {
+					actualBytes, _ := json.Marshal(actual)
+					expectedBytes, _ := json.Marshal(testCase.expected)
+
+					clabernetestesthelper.FailOutput(t, actualBytes, expectedBytes)
+				}
+			})
+	}
+}
@@ -90,3 +90,53 @@ func (c *Controller) processDefinition(
 
 	return processor.Process()
 }
+
+// resolveLinkImpairment returns (a copy of) the impairment configured in the topology spec for the
+// link between the given endpoints, or nil if the link has no impairment configured.
+func (p *definitionProcessor) resolveLinkImpairment(
+	endpointA, endpointB clabernetesapisv1alpha1.LinkEndpoint,
+) *clabernetesapisv1alpha1.LinkImpairment {
+	for idx := range p.topology.Spec.LinkImpairments {
+		linkImpairment := &p.topology.Spec.LinkImpairments[idx]
+
+		if linkEndpointsMatch(linkImpairment.Endpoints, endpointA, endpointB) {
+			return linkImpairment.Impairment.DeepCopy()
+		}
+	}
+
+	return nil
+}
+
+// resolveLinkState returns the administrative state configured in the topology spec for the link
+// between the given endpoints, or an empty string if the link has no state override configured.
+func (p *definitionProcessor) resolveLinkState(
+	endpointA, endpointB clabernetesapisv1alpha1.LinkEndpoint,
+) string {
+	for idx := range p.topology.Spec.LinkStates {
+		linkState := &p.topology.Spec.LinkStates[idx]
+
+		if linkEndpointsMatch(linkState.Endpoints, endpointA, endpointB) {
+			return linkState.State
+		}
+	}
+
+	return ""
+}
+
+// linkEndpointsMatch returns true if the given "node:interface" endpoints (as set in the topology
+// spec link impairments/states) refer to the link between the given endpoints, regardless of the
+// order of the endpoints.
+func linkEndpointsMatch(
+	endpoints []string,
+	endpointA, endpointB clabernetesapisv1alpha1.LinkEndpoint,
+) bool {
+	if len(endpoints) != clabernetesapisv1alpha1.LinkEndpointElementCount {
+		return false
+	}
+
+	endpointAName := fmt.Sprintf("%s:%s", endpointA.NodeName, endpointA.InterfaceName)
+	endpointBName := fmt.Sprintf("%s:%s", endpointB.NodeName, endpointB.InterfaceName)
+
+	return (endpoints[0] == endpointAName && endpoints[1] == endpointBName) ||
+		(endpoints[0] == endpointBName && endpoints[1] == endpointAName)
+}
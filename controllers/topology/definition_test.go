@@ -164,6 +164,153 @@ func TestDefinitionProcess(t *testing.T) {
 			},
 			removeTopologyPrefix: false,
 		},
+		{
+			name: "containerlab-bridge-segment",
+			inTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "process-containerlab-definition-bridge-segment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl3:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        lan1:
+          kind: ovs-bridge
+      links:
+        - endpoints: ["srl1:e1-1", "lan1:eth1"]
+        - endpoints: ["srl2:e1-1", "lan1:eth2"]
+        - endpoints: ["srl3:e1-1", "lan1:eth3"]
+`,
+					},
+				},
+			},
+			reconcileData: &clabernetescontrollerstopology.ReconcileData{
+				Kind:           "containerlab",
+				ResolvedHashes: clabernetesapisv1alpha1.ReconcileHashes{},
+				ResolvedConfigs: map[string]*clabernetesutilcontainerlab.Config{
+					"srl1": {},
+					"srl2": {},
+					"srl3": {},
+					"lan1": {},
+				},
+				ResolvedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+					"srl1": {},
+					"srl2": {},
+					"srl3": {},
+					"lan1": {},
+				},
+			},
+			removeTopologyPrefix: false,
+		},
+		{
+			name: "containerlab-link-impairments",
+			inTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "process-containerlab-definition-link-impairments-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e1-2", "srl2:e1-2"]
+`,
+					},
+					LinkImpairments: []clabernetesapisv1alpha1.TopologyLinkImpairment{
+						{
+							Endpoints: []string{"srl2:e1-2", "srl1:e1-2"},
+							Impairment: clabernetesapisv1alpha1.LinkImpairment{
+								Delay:  "50ms",
+								Jitter: "5ms",
+								Loss:   "0.5",
+								Rate:   10000,
+							},
+						},
+					},
+				},
+			},
+			reconcileData: &clabernetescontrollerstopology.ReconcileData{
+				Kind:           "containerlab",
+				ResolvedHashes: clabernetesapisv1alpha1.ReconcileHashes{},
+				ResolvedConfigs: map[string]*clabernetesutilcontainerlab.Config{
+					"srl1": {},
+					"srl2": {},
+				},
+				ResolvedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+					"srl1": {},
+					"srl2": {},
+				},
+			},
+			removeTopologyPrefix: false,
+		},
+		{
+			name: "containerlab-link-states",
+			inTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "process-containerlab-definition-link-states-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e1-2", "srl2:e1-2"]
+`,
+					},
+					LinkStates: []clabernetesapisv1alpha1.TopologyLinkState{
+						{
+							Endpoints: []string{"srl1:e1-1", "srl2:e1-1"},
+							State:     "down",
+						},
+					},
+				},
+			},
+			reconcileData: &clabernetescontrollerstopology.ReconcileData{
+				Kind:           "containerlab",
+				ResolvedHashes: clabernetesapisv1alpha1.ReconcileHashes{},
+				ResolvedConfigs: map[string]*clabernetesutilcontainerlab.Config{
+					"srl1": {},
+					"srl2": {},
+				},
+				ResolvedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+					"srl1": {},
+					"srl2": {},
+				},
+			},
+			removeTopologyPrefix: false,
+		},
 		{
 			name: "containerlab-simple-remove-prefix",
 			inTopology: &clabernetesapisv1alpha1.Topology{
@@ -365,6 +512,8 @@ func TestDefinitionProcess(t *testing.T) {
           kind: nokia_srsim
           type: sr-7
           network-mode: container:srsim-a
+          ports:
+            - 60830:830/tcp
           env:
             NOKIA_SROS_SLOT: B
         srsim-iom1:
@@ -400,6 +549,174 @@ func TestDefinitionProcess(t *testing.T) {
 			},
 			removeTopologyPrefix: false,
 		},
+		{
+			name: "containerlab-node-groups",
+			inTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "process-containerlab-definition-node-groups-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        dut1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        tgen1:
+          kind: linux
+          image: ghcr.io/srl-labs/network-multitool
+        dut2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["dut1:e1-1", "tgen1:eth1"]
+        - endpoints: ["tgen1:eth2", "dut2:e1-2"]
+        - endpoints: ["dut1:e1-3", "dut2:e1-3"]
+`,
+					},
+					Deployment: clabernetesapisv1alpha1.Deployment{
+						NodeGroups: map[string][]string{
+							"dut1": {"tgen1"},
+						},
+					},
+				},
+			},
+			reconcileData: &clabernetescontrollerstopology.ReconcileData{
+				Kind:           "containerlab",
+				ResolvedHashes: clabernetesapisv1alpha1.ReconcileHashes{},
+				ResolvedConfigs: map[string]*clabernetesutilcontainerlab.Config{
+					// tgen1 is co-located with dut1, so only dut1 and dut2 have entries
+					"dut1": {},
+					"dut2": {},
+				},
+				ResolvedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+					"dut1": {},
+					"dut2": {},
+				},
+			},
+			removeTopologyPrefix: false,
+		},
+		{
+			name: "containerlab-node-groups-shared-interface",
+			inTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "process-containerlab-definition-node-groups-shared-interface-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        dut1:
+          kind: linux
+          image: ghcr.io/srl-labs/network-multitool
+        tgen1:
+          kind: linux
+          image: ghcr.io/srl-labs/network-multitool
+        dut2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["dut1:eth1", "dut2:e1-1"]
+        - endpoints: ["tgen1:eth1", "dut2:e1-2"]
+`,
+					},
+					Deployment: clabernetesapisv1alpha1.Deployment{
+						NodeGroups: map[string][]string{
+							"dut1": {"tgen1"},
+						},
+					},
+				},
+			},
+			reconcileData: &clabernetescontrollerstopology.ReconcileData{
+				Kind:           "containerlab",
+				ResolvedHashes: clabernetesapisv1alpha1.ReconcileHashes{},
+				ResolvedConfigs: map[string]*clabernetesutilcontainerlab.Config{
+					// tgen1 is co-located with dut1, both use eth1 so the tunnels of the dut1
+					// launcher share the local interface name
+					"dut1": {},
+					"dut2": {},
+				},
+				ResolvedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+					"dut1": {},
+					"dut2": {},
+				},
+			},
+			removeTopologyPrefix: false,
+		},
+		{
+			name: "containerlab-wait-for",
+			inTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "process-containerlab-definition-wait-for-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        rr1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        dhcp1:
+          kind: linux
+          image: ghcr.io/srl-labs/network-multitool
+        client1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+          wait-for:
+            - rr1
+            - tgen1
+        tgen1:
+          kind: linux
+          image: ghcr.io/srl-labs/network-multitool
+        client2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+          stages:
+            create:
+              wait-for:
+                - node: dhcp1
+                  stage: healthy
+                - node: tgen1
+                  stage: create
+      links:
+        - endpoints: ["client1:e1-1", "rr1:e1-1"]
+        - endpoints: ["client2:e1-1", "rr1:e1-2"]
+`,
+					},
+					Deployment: clabernetesapisv1alpha1.Deployment{
+						NodeGroups: map[string][]string{
+							"client1": {"tgen1"},
+						},
+					},
+				},
+			},
+			reconcileData: &clabernetescontrollerstopology.ReconcileData{
+				Kind:           "containerlab",
+				ResolvedHashes: clabernetesapisv1alpha1.ReconcileHashes{},
+				ResolvedConfigs: map[string]*clabernetesutilcontainerlab.Config{
+					"rr1":     {},
+					"dhcp1":   {},
+					"client1": {},
+					"client2": {},
+				},
+				ResolvedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+					"rr1":     {},
+					"dhcp1":   {},
+					"client1": {},
+					"client2": {},
+				},
+			},
+			removeTopologyPrefix: false,
+		},
 	}
 
 	for _, testCase := range cases {
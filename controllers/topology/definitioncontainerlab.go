@@ -2,6 +2,7 @@ package topology
 
 import (
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 
@@ -30,24 +31,28 @@ func parseNetworkModeContainer(networkMode string) string {
 	return strings.TrimPrefix(networkMode, networkModeContainerPrefix)
 }
 
-// nodeGroup represents a group of nodes that share the same network namespace.
-// The primary node is the one that other nodes reference via network-mode: container:<primary>.
+// nodeGroup represents a group of nodes that are deployed in the same launcher pod -- either
+// because they share the same network namespace (the primary node is the one that other nodes
+// reference via network-mode: container:<primary>), or because they are explicitly co-located
+// via the topology's Deployment.NodeGroups.
 type nodeGroup struct {
 	primary     string
 	secondaries []string
 }
 
 // buildNodeGroups analyzes the topology nodes and identifies groups of nodes that share
-// network namespaces via the network-mode: container:<name> directive.
+// network namespaces via the network-mode: container:<name> directive, then adds the explicitly
+// configured (co-located) node groups.
 // Returns a map of primary node names to their groups, and a set of secondary node names.
 func buildNodeGroups(
-	nodes map[string]*clabernetesutilcontainerlab.NodeDefinition,
-) (groups map[string]*nodeGroup, secondaryNodes map[string]string) {
+	topology *clabernetesutilcontainerlab.Topology,
+	configuredNodeGroups map[string][]string,
+) (groups map[string]*nodeGroup, secondaryNodes map[string]string, err error) {
 	groups = make(map[string]*nodeGroup)
 	secondaryNodes = make(map[string]string) // maps secondary -> primary
 
 	// First pass: identify all secondaries and their primaries
-	for nodeName, nodeDefinition := range nodes {
+	for nodeName, nodeDefinition := range topology.Nodes {
 		primaryName := parseNetworkModeContainer(nodeDefinition.NetworkMode)
 		if primaryName == "" {
 			continue
@@ -67,7 +72,90 @@ func buildNodeGroups(
 		groups[primaryName].secondaries = append(groups[primaryName].secondaries, nodeName)
 	}
 
-	return groups, secondaryNodes
+	// Second pass: add the configured node groups, sorted so any error is deterministic
+	for _, primaryName := range slices.Sorted(maps.Keys(configuredNodeGroups)) {
+		err = validateConfiguredNodeGroupNode(topology, secondaryNodes, primaryName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, nodeName := range configuredNodeGroups[primaryName] {
+			err = validateConfiguredNodeGroupNode(topology, secondaryNodes, nodeName)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if len(topology.Nodes[nodeName].Ports) > 0 {
+				return nil, nil, fmt.Errorf(
+					"%w: node %q of node group %q declares ports, only the primary of a node"+
+						" group is exposed, declare the ports on node %q instead",
+					claberneteserrors.ErrInvalidData,
+					nodeName,
+					primaryName,
+					primaryName,
+				)
+			}
+
+			_, isConfiguredPrimary := configuredNodeGroups[nodeName]
+
+			if nodeName == primaryName || isConfiguredPrimary || groups[nodeName] != nil {
+				return nil, nil, fmt.Errorf(
+					"%w: node %q of node group %q is the primary of a node group itself",
+					claberneteserrors.ErrInvalidData,
+					nodeName,
+					primaryName,
+				)
+			}
+
+			secondaryNodes[nodeName] = primaryName
+
+			if groups[primaryName] == nil {
+				groups[primaryName] = &nodeGroup{
+					primary:     primaryName,
+					secondaries: []string{},
+				}
+			}
+
+			groups[primaryName].secondaries = append(groups[primaryName].secondaries, nodeName)
+		}
+	}
+
+	return groups, secondaryNodes, nil
+}
+
+// validateConfiguredNodeGroupNode checks that a node referenced in a configured node group exists,
+// is not a segment (bridge) node, and is not already a secondary node of another group.
+func validateConfiguredNodeGroupNode(
+	topology *clabernetesutilcontainerlab.Topology,
+	secondaryNodes map[string]string,
+	nodeName string,
+) error {
+	if _, ok := topology.Nodes[nodeName]; !ok {
+		return fmt.Errorf(
+			"%w: node group references unknown node %q",
+			claberneteserrors.ErrInvalidData,
+			nodeName,
+		)
+	}
+
+	if topology.IsSegmentNode(nodeName) {
+		return fmt.Errorf(
+			"%w: node group references segment node %q, segment nodes cannot be grouped",
+			claberneteserrors.ErrInvalidData,
+			nodeName,
+		)
+	}
+
+	if primaryName, ok := secondaryNodes[nodeName]; ok {
+		return fmt.Errorf(
+			"%w: node group references node %q which is already grouped with node %q",
+			claberneteserrors.ErrInvalidData,
+			nodeName,
+			primaryName,
+		)
+	}
+
+	return nil
 }
 
 func (p *containerlabDefinitionProcessor) Process() error {
@@ -92,7 +180,16 @@ func (p *containerlabDefinitionProcessor) Process() error {
 	removeTopologyPrefix := p.getRemoveTopologyPrefix()
 
 	// Build node groups for distributed systems (e.g., SR-SIM with network-mode: container:<name>)
-	nodeGroups, secondaryNodes := buildNodeGroups(containerlabConfig.Topology.Nodes)
+	// and for explicitly co-located nodes
+	nodeGroups, secondaryNodes, err := buildNodeGroups(
+		containerlabConfig.Topology,
+		p.topology.Spec.Deployment.NodeGroups,
+	)
+	if err != nil {
+		p.logger.Criticalf("failed building node groups, error: %s", err)
+
+		return err
+	}
 
 	for nodeName := range containerlabConfig.Topology.Nodes {
 		// Skip secondary nodes - they will be processed as part of their primary's group
@@ -116,6 +213,13 @@ func (p *containerlabDefinitionProcessor) Process() error {
 		}
 	}
 
+	err = validateWaitFor(p.reconcileData.ResolvedConfigs)
+	if err != nil {
+		p.logger.Criticalf("invalid node dependencies, error: %s", err)
+
+		return err
+	}
+
 	return nil
 }
 
@@ -453,14 +557,24 @@ func buildNodesMapForGroup(
 ) map[string]*clabernetesutilcontainerlab.NodeDefinition {
 	nodesMap := make(map[string]*clabernetesutilcontainerlab.NodeDefinition)
 
+	moveSecondaryPortsToPrimary(ctx)
+
 	for _, nodeName := range ctx.groupNodeNames {
 		nodeDefinition := ctx.containerlabConfig.Topology.Nodes[nodeName]
 
-		isSecondaryNode := parseNetworkModeContainer(nodeDefinition.NetworkMode) != ""
+		// secondary nodes (whether sharing the primary's network namespace or just co-located with
+		// it) are not exposed, only the primary is
+		isSecondaryNode := nodeName != ctx.primaryNodeName
 
 		switch {
 		case isSecondaryNode:
 			nodeDefinition.Ports = []string{}
+		case ctx.containerlabConfig.Topology.IsSegmentNode(nodeName):
+			// segment (bridge) nodes are just a linux bridge in the launcher, there is nothing to
+			// expose, and since the launcher has no openvswitch, ovs-bridges become linux bridges
+			nodeDefinition.Kind = clabernetesconstants.ContainerlabKindBridge
+			nodeDefinition.Ports = []string{}
+			ctx.deepCopiedDefaults.Ports = []string{}
 		case !ctx.disableExpose && !ctx.disableAutoExpose:
 			defaultPorts, nodePorts := processPorts(
 				ctx.containerlabConfig.Topology.Defaults.Ports,
@@ -479,6 +593,25 @@ func buildNodesMapForGroup(
 	return nodesMap
 }
 
+// moveSecondaryPortsToPrimary moves the ports declared on the secondary nodes of the group to the
+// primary node. Only secondaries sharing the network namespace of the primary can have ports
+// (co-located nodes with ports are rejected when building the node groups), those ports are
+// reachable via the network namespace of the primary anyway.
+func moveSecondaryPortsToPrimary(ctx *nodeGroupContext) {
+	if ctx.group == nil {
+		return
+	}
+
+	primaryNode := ctx.containerlabConfig.Topology.Nodes[ctx.primaryNodeName]
+
+	for _, nodeName := range ctx.group.secondaries {
+		nodeDefinition := ctx.containerlabConfig.Topology.Nodes[nodeName]
+
+		primaryNode.Ports = append(primaryNode.Ports, nodeDefinition.Ports...)
+		nodeDefinition.Ports = []string{}
+	}
+}
+
 // collectKindsForGroup collects all kinds used by nodes in the group.
 func collectKindsForGroup(
 	topology *clabernetesutilcontainerlab.Topology,
@@ -522,6 +655,149 @@ func moveDefaultsPortsToPrimary(
 	defaults.Ports = []string{}
 }
 
+// resolveWaitForForGroup removes the wait-for dependencies (plain and per stage) of the group's
+// nodes that point to nodes outside the group -- those nodes do not exist in the sub-topology so
+// containerlab would fail on them. It returns the sorted (primary) nodes of the sub-topologies
+// that are depended on so the launcher can wait for them before deploying instead.
+func resolveWaitForForGroup(
+	topology *clabernetesutilcontainerlab.Topology,
+	nodesMap map[string]*clabernetesutilcontainerlab.NodeDefinition,
+	groupNodesSet clabernetesutil.StringSet,
+	secondaryNodes map[string]string,
+) ([]string, error) {
+	dependencies := clabernetesutil.NewStringSet()
+
+	// isLocal returns true if the dependency can be handled by containerlab in the launcher,
+	// otherwise it records the sub-topology the dependency is deployed in
+	isLocal := func(nodeName, dependencyName string) (bool, error) {
+		if groupNodesSet.Contains(dependencyName) {
+			return true, nil
+		}
+
+		if _, ok := topology.Nodes[dependencyName]; !ok {
+			return false, fmt.Errorf(
+				"%w: node %q waits for unknown node %q",
+				claberneteserrors.ErrInvalidData,
+				nodeName,
+				dependencyName,
+			)
+		}
+
+		if primaryName, isSecondary := secondaryNodes[dependencyName]; isSecondary {
+			dependencyName = primaryName
+		}
+
+		dependencies.Add(dependencyName)
+
+		return false, nil
+	}
+
+	for _, nodeName := range slices.Sorted(maps.Keys(nodesMap)) {
+		nodeDefinition := nodesMap[nodeName]
+
+		localWaitFor := make([]string, 0, len(nodeDefinition.WaitFor))
+
+		for _, dependencyName := range nodeDefinition.WaitFor {
+			local, err := isLocal(nodeName, dependencyName)
+			if err != nil {
+				return nil, err
+			}
+
+			if local {
+				localWaitFor = append(localWaitFor, dependencyName)
+			}
+		}
+
+		if len(localWaitFor) == 0 {
+			localWaitFor = nil
+		}
+
+		nodeDefinition.WaitFor = localWaitFor
+
+		if nodeDefinition.Stages == nil {
+			continue
+		}
+
+		for _, stage := range nodeDefinition.Stages.All() {
+			localStageWaitFor := make([]*clabernetesutilcontainerlab.StageWaitFor, 0)
+
+			for _, stageWaitFor := range stage.WaitFor {
+				local, err := isLocal(nodeName, stageWaitFor.Node)
+				if err != nil {
+					return nil, err
+				}
+
+				if local {
+					localStageWaitFor = append(localStageWaitFor, stageWaitFor)
+				}
+			}
+
+			if len(localStageWaitFor) == 0 {
+				localStageWaitFor = nil
+			}
+
+			stage.WaitFor = localStageWaitFor
+		}
+	}
+
+	if dependencies.Len() == 0 {
+		return nil, nil
+	}
+
+	return slices.Sorted(slices.Values(dependencies.Items())), nil
+}
+
+// validateWaitFor checks that the dependencies between the sub-topologies do not form a cycle,
+// as the launchers would otherwise wait for each other forever.
+func validateWaitFor(configs map[string]*clabernetesutilcontainerlab.Config) error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := make(map[string]int, len(configs))
+
+	var visit func(nodeName string) error
+
+	visit = func(nodeName string) error {
+		switch state[nodeName] {
+		case visiting:
+			return fmt.Errorf(
+				"%w: node %q is part of a wait-for dependency cycle",
+				claberneteserrors.ErrInvalidData,
+				nodeName,
+			)
+		case visited:
+			return nil
+		}
+
+		state[nodeName] = visiting
+
+		config, ok := configs[nodeName]
+		if ok {
+			for _, dependencyName := range config.WaitFor {
+				err := visit(dependencyName)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		state[nodeName] = visited
+
+		return nil
+	}
+
+	for _, nodeName := range slices.Sorted(maps.Keys(configs)) {
+		err := visit(nodeName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // linkEndpoints holds parsed link endpoint information.
 type linkEndpoints struct {
 	endpointA clabernetesapisv1alpha1.LinkEndpoint
@@ -600,6 +876,18 @@ func (p *containerlabDefinitionProcessor) processConfigForNodeGroup(
 
 	moveDefaultsPortsToPrimary(nodesMap, primaryNodeName, group, deepCopiedDefaults)
 
+	waitFor, err := resolveWaitForForGroup(
+		containerlabConfig.Topology,
+		nodesMap,
+		groupNodesSet,
+		secondaryNodes,
+	)
+	if err != nil {
+		p.logger.Criticalf("failed resolving node dependencies, error: %s", err)
+
+		return err
+	}
+
 	p.reconcileData.ResolvedConfigs[primaryNodeName] = &clabernetesutilcontainerlab.Config{
 		Name: fmt.Sprintf("clabernetes-%s", primaryNodeName),
 		Mgmt: containerlabConfig.Mgmt,
@@ -609,7 +897,8 @@ func (p *containerlabDefinitionProcessor) processConfigForNodeGroup(
 			Nodes:    nodesMap,
 			Links:    nil,
 		},
-		Prefix: clabernetesutil.ToPointer(""),
+		Prefix:  clabernetesutil.ToPointer(""),
+		WaitFor: waitFor,
 	}
 
 	return p.processLinksForNodeGroup(
@@ -725,6 +1014,14 @@ func (p *containerlabDefinitionProcessor) processLinkForGroup(
 			),
 			LocalInterface:  interestingEndpoint.InterfaceName,
 			RemoteInterface: uninterestingEndpoint.InterfaceName,
+			Impairment: p.resolveLinkImpairment(
+				interestingEndpoint,
+				uninterestingEndpoint,
+			),
+			State: p.resolveLinkState(
+				interestingEndpoint,
+				uninterestingEndpoint,
+			),
 		},
 	)
 
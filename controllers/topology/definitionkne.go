@@ -111,6 +111,14 @@ func (p *kneDefinitionProcessor) processConfigNodeLinks(
 			),
 			LocalInterface:  interestingEndpoint.InterfaceName,
 			RemoteInterface: uninterestingEndpoint.InterfaceName,
+			Impairment: p.resolveLinkImpairment(
+				interestingEndpoint,
+				uninterestingEndpoint,
+			),
+			State: p.resolveLinkState(
+				interestingEndpoint,
+				uninterestingEndpoint,
+			),
 		},
 	)
 }
@@ -142,7 +150,7 @@ func (p *kneDefinitionProcessor) processKneDefinition(
 			)
 		}
 
-		image := nodeDefinition.Config.Image
+		image := nodeDefinition.GetConfig().GetImage()
 		if image == "" {
 			image = clabernetesutilkne.VendorModelToImage(kneVendor, kneModel)
 
@@ -25,10 +25,10 @@ import (
 )
 
 const (
-	probeInitialDelay                   = 60
-	probePeriodSeconds                  = 20
-	probeReadinessFailureThreshold      = 3
-	probeDefaultStartupFailureThreshold = 40
+	probeInitialDelay              = 60
+	probePeriodSeconds             = 20
+	probeReadinessFailureThreshold = 3
+	probeDefaultStartupSeconds     = 800
 )
 
 // DeploymentReconciler is a subcomponent of the "TopologyReconciler" but is exposed for testing
@@ -185,6 +185,7 @@ func (r *DeploymentReconciler) Render(
 		deployment,
 		nodeName,
 		owningTopology,
+		clabernetesConfigs,
 	)
 
 	r.renderDeploymentDevices(
@@ -391,6 +392,10 @@ func (r *DeploymentReconciler) renderDeploymentBase(
 					ServiceAccountName: launcherServiceAccountName(),
 					Volumes:            []k8scorev1.Volume{},
 					Hostname:           nodeName,
+					// give the launcher enough time to save/destroy the containerlab topology
+					TerminationGracePeriodSeconds: clabernetesutil.ToPointer(
+						int64(clabernetesconstants.LauncherTerminationGracePeriodSeconds),
+					),
 				},
 			},
 		},
@@ -466,6 +471,33 @@ func (r *DeploymentReconciler) renderDeploymentVolumes( //nolint:funlen
 				SubPath: criSubPath,
 			},
 		)
+
+		imageCacheHostPath := r.configManagerGetter().GetImagePullImageCacheHostPath()
+		if imageCacheHostPath != "" {
+			// the image cache is shared by all launchers on a given node, images are only
+			// exported from the cri once per node rather than once per launcher
+			volumes = append(
+				volumes,
+				k8scorev1.Volume{
+					Name: "image-cache",
+					VolumeSource: k8scorev1.VolumeSource{
+						HostPath: &k8scorev1.HostPathVolumeSource{
+							Path: imageCacheHostPath,
+							Type: clabernetesutil.ToPointer(k8scorev1.HostPathDirectoryOrCreate),
+						},
+					},
+				},
+			)
+
+			volumeMountsFromCommonSpec = append(
+				volumeMountsFromCommonSpec,
+				k8scorev1.VolumeMount{
+					Name:      "image-cache",
+					ReadOnly:  false,
+					MountPath: clabernetesconstants.LauncherImageCachePath,
+				},
+			)
+		}
 	}
 
 	dockerDaemonConfigSecret := owningTopology.Spec.ImagePull.DockerDaemonConfig
@@ -530,6 +562,40 @@ func (r *DeploymentReconciler) renderDeploymentVolumes( //nolint:funlen
 		)
 	}
 
+	if owningTopology.Spec.Connectivity == clabernetesconstants.ConnectivityWireGuard {
+		// only mount the private key of this launcher, the public keys of the peers are in the
+		// connectivity cr
+		volumes = append(
+			volumes,
+			k8scorev1.Volume{
+				Name: "wireguard",
+				VolumeSource: k8scorev1.VolumeSource{
+					Secret: &k8scorev1.SecretVolumeSource{
+						SecretName: WireGuardSecretName(owningTopology),
+						Items: []k8scorev1.KeyToPath{
+							{
+								Key:  WireGuardPrivateKeySecretKey(nodeName),
+								Path: filepath.Base(clabernetesconstants.WireGuardPrivateKeyPath),
+							},
+						},
+						DefaultMode: clabernetesutil.ToPointer(
+							int32(clabernetesconstants.PermissionsOwnerRead),
+						),
+					},
+				},
+			},
+		)
+
+		volumeMountsFromCommonSpec = append(
+			volumeMountsFromCommonSpec,
+			k8scorev1.VolumeMount{
+				Name:      "wireguard",
+				ReadOnly:  true,
+				MountPath: filepath.Dir(clabernetesconstants.WireGuardPrivateKeyPath),
+			},
+		)
+	}
+
 	volumesFromConfigMaps := make([]clabernetesapisv1alpha1.FileFromConfigMap, 0) //nolint: prealloc
 
 	volumesFromConfigMaps = append(
@@ -634,9 +700,17 @@ func (r *DeploymentReconciler) renderDeploymentVolumesGetCRISockPath(
 			path = clabernetesconstants.KubernetesCRISockContainerdPath
 
 			subPath = clabernetesconstants.KubernetesCRISockContainerd
+		case clabernetesconstants.KubernetesCRICrio:
+			path = clabernetesconstants.KubernetesCRIStorageCrioPath
+
+			subPath = clabernetesconstants.KubernetesCRIStorageCrio
+		case clabernetesconstants.KubernetesCRIDocker:
+			path = clabernetesconstants.KubernetesCRISockDockerPath
+
+			subPath = clabernetesconstants.KubernetesCRISockDocker
 		default:
 			r.log.Warnf(
-				"image pull through mode is auto or always but cri kind is not containerd!"+
+				"image pull through mode is auto or always but cri kind is not known!"+
 					" got cri kind %q",
 				r.criKind,
 			)
@@ -663,6 +737,14 @@ func (r *DeploymentReconciler) renderDeploymentContainer(
 		imagePullPolicy = r.configManagerGetter().GetLauncherImagePullPolicy()
 	}
 
+	launcherRuntime := owningTopology.Spec.Deployment.LauncherRuntime
+
+	// the "docker" volume holds the images/containers of whatever runtime the launcher uses
+	runtimeStoragePath := "/var/lib/docker"
+	if launcherRuntime == clabernetesconstants.LauncherRuntimePodman {
+		runtimeStoragePath = "/var/lib/containers"
+	}
+
 	container := k8scorev1.Container{
 		Name:       nodeName,
 		WorkingDir: "/clabernetes",
@@ -679,6 +761,21 @@ func (r *DeploymentReconciler) renderDeploymentContainer(
 				ContainerPort: clabernetesconstants.SlurpeethServicePort,
 				Protocol:      clabernetesconstants.TCP,
 			},
+			{
+				Name:          clabernetesconstants.ConnectivityGENEVE,
+				ContainerPort: clabernetesconstants.GENEVEServicePort,
+				Protocol:      clabernetesconstants.UDP,
+			},
+			{
+				Name:          clabernetesconstants.ConnectivityWireGuard,
+				ContainerPort: clabernetesconstants.WireGuardServicePort,
+				Protocol:      clabernetesconstants.UDP,
+			},
+			{
+				Name:          clabernetesconstants.MetricsPortName,
+				ContainerPort: clabernetesconstants.MetricsPort,
+				Protocol:      clabernetesconstants.TCP,
+			},
 		},
 		VolumeMounts: []k8scorev1.VolumeMount{
 			{
@@ -702,7 +799,7 @@ func (r *DeploymentReconciler) renderDeploymentContainer(
 			{
 				Name:      "docker",
 				ReadOnly:  false,
-				MountPath: "/var/lib/docker",
+				MountPath: runtimeStoragePath,
 			},
 		},
 		TerminationMessagePath:   "/dev/termination-log",
@@ -737,8 +834,10 @@ func (r *DeploymentReconciler) renderDeploymentContainerEnv( //nolint: funlen
 		criKind = r.criKind
 	}
 
+	isSegmentNode := clabernetesConfigs[nodeName].Topology.IsSegmentNode(nodeName)
+
 	nodeImage := clabernetesConfigs[nodeName].Topology.GetNodeImage(nodeName)
-	if nodeImage == "" {
+	if nodeImage == "" && !isSegmentNode {
 		r.log.Warnf(
 			"could not parse image for node %q, topology in question printined in debug log",
 			nodeName,
@@ -849,6 +948,48 @@ func (r *DeploymentReconciler) renderDeploymentContainerEnv( //nolint: funlen
 		)
 	}
 
+	if isSegmentNode {
+		envs = append(
+			envs,
+			k8scorev1.EnvVar{
+				Name:  clabernetesconstants.LauncherSegmentEnv,
+				Value: clabernetesconstants.True,
+			},
+		)
+	}
+
+	launcherRuntime := owningTopology.Spec.Deployment.LauncherRuntime
+	if launcherRuntime == clabernetesconstants.LauncherRuntimePodman {
+		envs = append(
+			envs,
+			k8scorev1.EnvVar{
+				Name:  clabernetesconstants.LauncherRuntimeEnv,
+				Value: clabernetesconstants.LauncherRuntimePodman,
+			},
+		)
+	}
+
+	if len(clabernetesConfigs[nodeName].WaitFor) > 0 {
+		envs = append(
+			envs,
+			k8scorev1.EnvVar{
+				Name:  clabernetesconstants.LauncherWaitForNodesEnv,
+				Value: strings.Join(clabernetesConfigs[nodeName].WaitFor, ","),
+			},
+		)
+	}
+
+	if imagePullThroughMode != clabernetesconstants.ImagePullThroughModeNever &&
+		r.configManagerGetter().GetImagePullImageCacheHostPath() != "" {
+		envs = append(
+			envs,
+			k8scorev1.EnvVar{
+				Name:  clabernetesconstants.LauncherImageCacheEnv,
+				Value: clabernetesconstants.True,
+			},
+		)
+	}
+
 	if owningTopology.Spec.Deployment.Persistence.Enabled {
 		envs = append(
 			envs,
@@ -857,6 +998,16 @@ func (r *DeploymentReconciler) renderDeploymentContainerEnv( //nolint: funlen
 				Value: clabernetesconstants.True,
 			},
 		)
+
+		if owningTopology.Spec.Deployment.Persistence.SaveOnTeardown {
+			envs = append(
+				envs,
+				k8scorev1.EnvVar{
+					Name:  clabernetesconstants.LauncherContainerlabSaveOnTeardown,
+					Value: clabernetesconstants.True,
+				},
+			)
+		}
 	}
 
 	if len(owningTopology.Spec.ImagePull.InsecureRegistries) > 0 {
@@ -934,14 +1085,11 @@ func (r *DeploymentReconciler) renderDeploymentNodeSelectors(
 	owningTopology *clabernetesapisv1alpha1.Topology,
 	clabernetesConfigs map[string]*clabernetesutilcontainerlab.Config,
 ) {
-	nodeImage := clabernetesConfigs[nodeName].Topology.GetNodeImage(nodeName)
-
-	nodeSelectors := r.configManagerGetter().GetNodeSelectorsByImage(nodeImage)
-	if len(nodeSelectors) == 0 {
-		maps.Copy(nodeSelectors, owningTopology.Spec.Deployment.Scheduling.NodeSelector)
-	}
-
-	deployment.Spec.Template.Spec.NodeSelector = nodeSelectors
+	deployment.Spec.Template.Spec.NodeSelector = ResolveNodeSelectors(
+		r.configManagerGetter(),
+		owningTopology,
+		clabernetesConfigs[nodeName].Topology.GetNodeImage(nodeName),
+	)
 }
 
 func (r *DeploymentReconciler) renderDeploymentContainerPrivileges(
@@ -1033,11 +1181,17 @@ func (r *DeploymentReconciler) renderDeploymentContainerStatus(
 	deployment *k8sappsv1.Deployment,
 	nodeName string,
 	owningTopology *clabernetesapisv1alpha1.Topology,
+	clabernetesConfigs map[string]*clabernetesutilcontainerlab.Config,
 ) {
 	if !owningTopology.Spec.StatusProbes.Enabled {
 		return
 	}
 
+	if clabernetesConfigs[nodeName].Topology.IsSegmentNode(nodeName) {
+		// segment (bridge) nodes have no container to probe
+		return
+	}
+
 	if slices.Contains(owningTopology.Spec.StatusProbes.ExcludedNodes, nodeName) {
 		// this clab node was excluded, dont setup probes
 		return
@@ -1049,81 +1203,149 @@ func (r *DeploymentReconciler) renderDeploymentContainerStatus(
 	}
 
 	if nodeProbeConfiguration.SSHProbeConfiguration == nil &&
-		nodeProbeConfiguration.TCPProbeConfiguration == nil {
+		nodeProbeConfiguration.TCPProbeConfiguration == nil &&
+		nodeProbeConfiguration.HTTPProbeConfiguration == nil &&
+		nodeProbeConfiguration.GNMIProbeConfiguration == nil &&
+		nodeProbeConfiguration.NETCONFProbeConfiguration == nil &&
+		nodeProbeConfiguration.ExecProbeConfiguration == nil {
 		r.log.Warnf("node %q has no status probe configurations, skipping...", nodeName)
 
 		return
 	}
 
-	// default failure threshold for startup probe == 40, 40*20 = 800 seconds startup probe total
-	// time (plus the 60s initial delay) for 15ish min startup time...
-	failureThresholds := probeDefaultStartupFailureThreshold
+	initialDelaySeconds := probeInitialDelay
+	if nodeProbeConfiguration.InitialDelaySeconds != nil {
+		initialDelaySeconds = *nodeProbeConfiguration.InitialDelaySeconds
+	}
+
+	periodSeconds := probePeriodSeconds
+	if nodeProbeConfiguration.IntervalSeconds != 0 {
+		periodSeconds = nodeProbeConfiguration.IntervalSeconds
+	}
+
+	readinessFailureThreshold := probeReadinessFailureThreshold
+	if nodeProbeConfiguration.ReadinessFailureThreshold != 0 {
+		readinessFailureThreshold = nodeProbeConfiguration.ReadinessFailureThreshold
+	}
+
+	// default startup time is 800 seconds (plus the 60s initial delay) for 15ish min startup
+	// time, at the default period of 20 seconds that is a failure threshold of 40
+	startupSeconds := probeDefaultStartupSeconds
 
 	if nodeProbeConfiguration.StartupSeconds != 0 {
-		failureThresholds = nodeProbeConfiguration.StartupSeconds / probePeriodSeconds
+		startupSeconds = nodeProbeConfiguration.StartupSeconds
 	}
 
-	// startup probe delays the start of the readiness probe -- this gives us time for the nos to
-	// boot before we start doing the readiness check on the (slightly) faster frequency
-	deployment.Spec.Template.Spec.Containers[0].StartupProbe = &k8scorev1.Probe{
-		ProbeHandler: k8scorev1.ProbeHandler{
-			Exec: &k8scorev1.ExecAction{
-				Command: []string{
-					"grep",
-					clabernetesconstants.NodeStatusHealthy,
-					clabernetesconstants.NodeStatusFile,
-				},
+	startupFailureThreshold := max(startupSeconds/periodSeconds, 1)
+
+	// the probes only check the status file the launcher writes, the launcher itself does the
+	// "real" probing at the same interval, so we can use the same probe handler for all probes
+	nodeStatusProbeHandler := k8scorev1.ProbeHandler{
+		Exec: &k8scorev1.ExecAction{
+			Command: []string{
+				"grep",
+				clabernetesconstants.NodeStatusHealthy,
+				clabernetesconstants.NodeStatusFile,
 			},
 		},
-		InitialDelaySeconds: probeInitialDelay,
+	}
+
+	// startup probe delays the start of the readiness probe -- this gives us time for the nos to
+	// boot before we start doing the readiness check
+	deployment.Spec.Template.Spec.Containers[0].StartupProbe = &k8scorev1.Probe{
+		ProbeHandler:        nodeStatusProbeHandler,
+		InitialDelaySeconds: int32(initialDelaySeconds), //nolint:gosec
 		TimeoutSeconds:      1,
 		SuccessThreshold:    1,
-		PeriodSeconds:       probePeriodSeconds,
-		FailureThreshold:    int32(failureThresholds),
+		PeriodSeconds:       int32(periodSeconds),           //nolint:gosec
+		FailureThreshold:    int32(startupFailureThreshold), //nolint:gosec
 	}
 
-	// after the startup probe has done its thing we set run the readiness probe -- since the
-	// launcher doenst check the status super frequently we keep this pretty slow too
+	// after the startup probe has done its thing we set run the readiness probe
 	deployment.Spec.Template.Spec.Containers[0].ReadinessProbe = &k8scorev1.Probe{
-		ProbeHandler: k8scorev1.ProbeHandler{
-			Exec: &k8scorev1.ExecAction{
-				Command: []string{
-					"grep",
-					clabernetesconstants.NodeStatusHealthy,
-					clabernetesconstants.NodeStatusFile,
-				},
-			},
-		},
+		ProbeHandler:     nodeStatusProbeHandler,
 		TimeoutSeconds:   1,
 		SuccessThreshold: 1,
-		PeriodSeconds:    probePeriodSeconds,
-		FailureThreshold: probeReadinessFailureThreshold,
+		PeriodSeconds:    int32(periodSeconds),             //nolint:gosec
+		FailureThreshold: int32(readinessFailureThreshold), //nolint:gosec
+	}
+
+	livenessRestartPolicy := nodeProbeConfiguration.LivenessRestartPolicy
+	if livenessRestartPolicy == "" {
+		livenessRestartPolicy = clabernetesconstants.LivenessRestartPolicyLauncher
+	}
+
+	if nodeProbeConfiguration.LivenessFailureThreshold != 0 &&
+		livenessRestartPolicy == clabernetesconstants.LivenessRestartPolicyLauncher {
+		// liveness is opt-in as it restarts the launcher (and so the node) when failing, the
+		// other restart policies are handled by the launcher itself (see below)
+		deployment.Spec.Template.Spec.Containers[0].LivenessProbe = &k8scorev1.Probe{
+			ProbeHandler:     nodeStatusProbeHandler,
+			TimeoutSeconds:   1,
+			SuccessThreshold: 1,
+			PeriodSeconds:    int32(periodSeconds), //nolint:gosec
+			FailureThreshold: int32( //nolint:gosec
+				nodeProbeConfiguration.LivenessFailureThreshold,
+			),
+		}
 	}
 
 	probeEnvVars := make([]k8scorev1.EnvVar, 0)
 
-	if nodeProbeConfiguration.TCPProbeConfiguration != nil {
+	if nodeProbeConfiguration.IntervalSeconds != 0 {
 		probeEnvVars = append(
 			probeEnvVars,
 			k8scorev1.EnvVar{
-				Name:  clabernetesconstants.LauncherTCPProbePort,
-				Value: strconv.Itoa(nodeProbeConfiguration.TCPProbeConfiguration.Port),
+				Name:  clabernetesconstants.LauncherProbeInterval,
+				Value: strconv.Itoa(nodeProbeConfiguration.IntervalSeconds),
 			},
 		)
 	}
 
-	if nodeProbeConfiguration.SSHProbeConfiguration != nil {
+	if nodeProbeConfiguration.TimeoutSeconds != 0 {
 		probeEnvVars = append(
 			probeEnvVars,
 			k8scorev1.EnvVar{
-				Name:  clabernetesconstants.LauncherSSHProbeUsername,
-				Value: nodeProbeConfiguration.SSHProbeConfiguration.Username,
+				Name:  clabernetesconstants.LauncherProbeTimeout,
+				Value: strconv.Itoa(nodeProbeConfiguration.TimeoutSeconds),
 			},
+		)
+	}
+
+	if nodeProbeConfiguration.LivenessFailureThreshold != 0 &&
+		livenessRestartPolicy != clabernetesconstants.LivenessRestartPolicyLauncher {
+		probeEnvVars = append(
+			probeEnvVars,
+			k8scorev1.EnvVar{
+				Name: clabernetesconstants.LauncherLivenessFailureThreshold,
+				Value: strconv.Itoa(
+					nodeProbeConfiguration.LivenessFailureThreshold,
+				),
+			},
+			k8scorev1.EnvVar{
+				Name:  clabernetesconstants.LauncherLivenessRestartPolicy,
+				Value: livenessRestartPolicy,
+			},
+		)
+	}
+
+	if nodeProbeConfiguration.TCPProbeConfiguration != nil {
+		probeEnvVars = append(
+			probeEnvVars,
 			k8scorev1.EnvVar{
-				Name:  clabernetesconstants.LauncherSSHProbePassword,
-				Value: nodeProbeConfiguration.SSHProbeConfiguration.Password,
+				Name:  clabernetesconstants.LauncherTCPProbePort,
+				Value: strconv.Itoa(nodeProbeConfiguration.TCPProbeConfiguration.Port),
 			},
 		)
+	}
+
+	if nodeProbeConfiguration.SSHProbeConfiguration != nil {
+		probeEnvVars = append(
+			probeEnvVars,
+			renderDeploymentSSHProbeCredentialEnvVars(
+				nodeProbeConfiguration.SSHProbeConfiguration,
+			)...,
+		)
 
 		if nodeProbeConfiguration.SSHProbeConfiguration.Port != 0 {
 			probeEnvVars = append(
@@ -1136,12 +1358,252 @@ func (r *DeploymentReconciler) renderDeploymentContainerStatus(
 		}
 	}
 
+	probeEnvVars = append(
+		probeEnvVars,
+		renderDeploymentAdditionalProbeEnvVars(r.log, nodeName, nodeProbeConfiguration)...,
+	)
+
 	deployment.Spec.Template.Spec.Containers[0].Env = append(
 		deployment.Spec.Template.Spec.Containers[0].Env,
 		probeEnvVars...,
 	)
 }
 
+// probeCredential is a single credential of a status probe -- envName is the launcher env var the
+// credential is passed in, secretKey the key of the credential in the probe credentials secret and
+// inlineValue the value set inline in the probe configuration (if any).
+type probeCredential struct {
+	envName     string
+	secretKey   string
+	inlineValue string
+}
+
+// renderDeploymentSSHProbeCredentialEnvVars returns the env vars holding the ssh probe
+// credentials, see renderDeploymentProbeCredentialEnvVars.
+func renderDeploymentSSHProbeCredentialEnvVars(
+	sshProbeConfiguration *clabernetesapisv1alpha1.SSHProbeConfiguration,
+) []k8scorev1.EnvVar {
+	return renderDeploymentProbeCredentialEnvVars(
+		sshProbeConfiguration.CredentialsSecret,
+		[]probeCredential{
+			{
+				envName:     clabernetesconstants.LauncherSSHProbeUsername,
+				secretKey:   k8scorev1.BasicAuthUsernameKey,
+				inlineValue: sshProbeConfiguration.Username,
+			},
+			{
+				envName:     clabernetesconstants.LauncherSSHProbePassword,
+				secretKey:   k8scorev1.BasicAuthPasswordKey,
+				inlineValue: sshProbeConfiguration.Password,
+			},
+			{
+				envName:   clabernetesconstants.LauncherSSHProbePrivateKey,
+				secretKey: k8scorev1.SSHAuthPrivateKey,
+			},
+		},
+	)
+}
+
+// renderDeploymentProbeCredentialEnvVars returns the env vars holding the given probe credentials
+// -- inline credentials are set as plain values, anything else is referenced from the credentials
+// secret (if configured) so that it never shows up in the deployment itself.
+func renderDeploymentProbeCredentialEnvVars(
+	credentialsSecret string,
+	credentials []probeCredential,
+) []k8scorev1.EnvVar {
+	probeEnvVars := make([]k8scorev1.EnvVar, 0)
+
+	for _, credential := range credentials {
+		switch {
+		case credential.inlineValue != "":
+			probeEnvVars = append(
+				probeEnvVars,
+				k8scorev1.EnvVar{
+					Name:  credential.envName,
+					Value: credential.inlineValue,
+				},
+			)
+		case credentialsSecret != "":
+			probeEnvVars = append(
+				probeEnvVars,
+				k8scorev1.EnvVar{
+					Name: credential.envName,
+					ValueFrom: &k8scorev1.EnvVarSource{
+						SecretKeyRef: &k8scorev1.SecretKeySelector{
+							LocalObjectReference: k8scorev1.LocalObjectReference{
+								Name: credentialsSecret,
+							},
+							Key: credential.secretKey,
+							// not all keys need to be present, for example when using key based
+							// auth there may well be no password
+							Optional: clabernetesutil.ToPointer(true),
+						},
+					},
+				},
+			)
+		}
+	}
+
+	return probeEnvVars
+}
+
+func renderDeploymentAdditionalProbeEnvVars(
+	logger claberneteslogging.Instance,
+	nodeName string,
+	nodeProbeConfiguration clabernetesapisv1alpha1.ProbeConfiguration,
+) []k8scorev1.EnvVar {
+	probeEnvVars := make([]k8scorev1.EnvVar, 0)
+
+	if nodeProbeConfiguration.HTTPProbeConfiguration != nil {
+		httpProbeConfiguration := nodeProbeConfiguration.HTTPProbeConfiguration
+
+		probeEnvVars = append(
+			probeEnvVars,
+			k8scorev1.EnvVar{
+				Name:  clabernetesconstants.LauncherHTTPProbePort,
+				Value: strconv.Itoa(httpProbeConfiguration.Port),
+			},
+		)
+
+		if httpProbeConfiguration.Path != "" {
+			probeEnvVars = append(
+				probeEnvVars,
+				k8scorev1.EnvVar{
+					Name:  clabernetesconstants.LauncherHTTPProbePath,
+					Value: httpProbeConfiguration.Path,
+				},
+			)
+		}
+
+		if httpProbeConfiguration.Scheme != "" {
+			probeEnvVars = append(
+				probeEnvVars,
+				k8scorev1.EnvVar{
+					Name:  clabernetesconstants.LauncherHTTPProbeScheme,
+					Value: httpProbeConfiguration.Scheme,
+				},
+			)
+		}
+
+		if httpProbeConfiguration.ExpectedStatusCode != 0 {
+			probeEnvVars = append(
+				probeEnvVars,
+				k8scorev1.EnvVar{
+					Name:  clabernetesconstants.LauncherHTTPProbeExpectedStatusCode,
+					Value: strconv.Itoa(httpProbeConfiguration.ExpectedStatusCode),
+				},
+			)
+		}
+	}
+
+	if nodeProbeConfiguration.GNMIProbeConfiguration != nil {
+		gnmiProbeConfiguration := nodeProbeConfiguration.GNMIProbeConfiguration
+
+		probeEnvVars = append(
+			probeEnvVars,
+			k8scorev1.EnvVar{
+				Name:  clabernetesconstants.LauncherGNMIProbePort,
+				Value: strconv.Itoa(gnmiProbeConfiguration.Port),
+			},
+		)
+
+		probeEnvVars = append(
+			probeEnvVars,
+			renderDeploymentProbeCredentialEnvVars(
+				gnmiProbeConfiguration.CredentialsSecret,
+				[]probeCredential{
+					{
+						envName:     clabernetesconstants.LauncherGNMIProbeUsername,
+						secretKey:   k8scorev1.BasicAuthUsernameKey,
+						inlineValue: gnmiProbeConfiguration.Username,
+					},
+					{
+						envName:     clabernetesconstants.LauncherGNMIProbePassword,
+						secretKey:   k8scorev1.BasicAuthPasswordKey,
+						inlineValue: gnmiProbeConfiguration.Password,
+					},
+				},
+			)...,
+		)
+
+		probeEnvVars = append(
+			probeEnvVars,
+			k8scorev1.EnvVar{
+				Name:  clabernetesconstants.LauncherGNMIProbeInsecure,
+				Value: strconv.FormatBool(gnmiProbeConfiguration.Insecure),
+			},
+		)
+	}
+
+	if nodeProbeConfiguration.NETCONFProbeConfiguration != nil {
+		netconfProbeConfiguration := nodeProbeConfiguration.NETCONFProbeConfiguration
+
+		probeEnvVars = append(
+			probeEnvVars,
+			renderDeploymentProbeCredentialEnvVars(
+				netconfProbeConfiguration.CredentialsSecret,
+				[]probeCredential{
+					{
+						envName:     clabernetesconstants.LauncherNETCONFProbeUsername,
+						secretKey:   k8scorev1.BasicAuthUsernameKey,
+						inlineValue: netconfProbeConfiguration.Username,
+					},
+					{
+						envName:     clabernetesconstants.LauncherNETCONFProbePassword,
+						secretKey:   k8scorev1.BasicAuthPasswordKey,
+						inlineValue: netconfProbeConfiguration.Password,
+					},
+				},
+			)...,
+		)
+
+		if netconfProbeConfiguration.Port != 0 {
+			probeEnvVars = append(
+				probeEnvVars,
+				k8scorev1.EnvVar{
+					Name:  clabernetesconstants.LauncherNETCONFProbePort,
+					Value: strconv.Itoa(netconfProbeConfiguration.Port),
+				},
+			)
+		}
+	}
+
+	if nodeProbeConfiguration.ExecProbeConfiguration != nil {
+		execProbeConfiguration := nodeProbeConfiguration.ExecProbeConfiguration
+
+		commandBytes, err := json.Marshal(execProbeConfiguration.Command)
+		if err != nil {
+			logger.Warnf(
+				"failed marshaling exec probe command for node %q, skipping exec probe, error: %s",
+				nodeName,
+				err,
+			)
+
+			return probeEnvVars
+		}
+
+		probeEnvVars = append(
+			probeEnvVars,
+			k8scorev1.EnvVar{
+				Name:  clabernetesconstants.LauncherExecProbeCommand,
+				Value: string(commandBytes),
+			},
+		)
+
+		if execProbeConfiguration.ExpectedOutput != "" {
+			probeEnvVars = append(
+				probeEnvVars,
+				k8scorev1.EnvVar{
+					Name:  clabernetesconstants.LauncherExecProbeExpectedOutput,
+					Value: execProbeConfiguration.ExpectedOutput,
+				},
+			)
+		}
+	}
+
+	return probeEnvVars
+}
+
 func (r *DeploymentReconciler) renderDeploymentDevices(
 	deployment *k8sappsv1.Deployment,
 	owningTopology *clabernetesapisv1alpha1.Topology,
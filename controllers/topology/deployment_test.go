@@ -553,6 +553,580 @@ func TestRenderDeployment(t *testing.T) {
 			nodeName:            "srl1",
 			configManagerGetter: clabernetesconfig.GetFakeManager,
 		},
+		{
+			name: "wireguard",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Connectivity: clabernetesconstants.ConnectivityWireGuard,
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+		   name: test
+		   topology:
+		     nodes:
+		       srl1:
+		         kind: srl
+		         image: ghcr.io/nokia/srlinux
+		`,
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName:            "srl1",
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
+		{
+			name: "segment",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+		   name: test
+		   topology:
+		     nodes:
+		       lan1:
+		         kind: bridge
+		`,
+					},
+					StatusProbes: clabernetesapisv1alpha1.StatusProbes{
+						Enabled: true,
+						ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+							TCPProbeConfiguration: &clabernetesapisv1alpha1.TCPProbeConfiguration{
+								Port: 22,
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"lan1": {
+					Name:   "lan1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"lan1": {
+								Kind: "bridge",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName:            "lan1",
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
+		{
+			name: "status-probe-configuration",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+		   name: test
+		   topology:
+		     nodes:
+		       srl1:
+		         kind: srl
+		         image: ghcr.io/nokia/srlinux
+		`,
+					},
+					StatusProbes: clabernetesapisv1alpha1.StatusProbes{
+						Enabled: true,
+						ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+							StartupSeconds:            120,
+							InitialDelaySeconds:       clabernetesutil.ToPointer(0),
+							IntervalSeconds:           5,
+							TimeoutSeconds:            2,
+							ReadinessFailureThreshold: 2,
+							LivenessFailureThreshold:  6,
+							TCPProbeConfiguration: &clabernetesapisv1alpha1.TCPProbeConfiguration{
+								Port: 22,
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName:            "srl1",
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
+		{
+			name: "liveness-restart-policy-node",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+		   name: test
+		   topology:
+		     nodes:
+		       srl1:
+		         kind: srl
+		         image: ghcr.io/nokia/srlinux
+		`,
+					},
+					StatusProbes: clabernetesapisv1alpha1.StatusProbes{
+						Enabled: true,
+						ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+							StartupSeconds:            120,
+							InitialDelaySeconds:       clabernetesutil.ToPointer(0),
+							IntervalSeconds:           5,
+							TimeoutSeconds:            2,
+							ReadinessFailureThreshold: 2,
+							LivenessFailureThreshold:  3,
+							LivenessRestartPolicy:     clabernetesconstants.LivenessRestartPolicyNode,
+							TCPProbeConfiguration: &clabernetesapisv1alpha1.TCPProbeConfiguration{
+								Port: 22,
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName:            "srl1",
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
+		{
+			name: "wait-for-nodes",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        rr1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        dhcp1:
+          kind: linux
+          image: ghcr.io/srl-labs/network-multitool
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+          wait-for:
+            - rr1
+            - dhcp1
+`,
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug:   false,
+					WaitFor: []string{"dhcp1", "rr1"},
+				},
+			},
+			nodeName:            "srl1",
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
+		{
+			name: "launcher-runtime-podman",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+`,
+					},
+					Deployment: clabernetesapisv1alpha1.Deployment{
+						LauncherRuntime: clabernetesconstants.LauncherRuntimePodman,
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName:            "srl1",
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
+		{
+			name: "image-pull-through-crio",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+`,
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName:            "srl1",
+			criKind:             clabernetesconstants.KubernetesCRICrio,
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
+		{
+			name: "image-cache",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+`,
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName: "srl1",
+			criKind:  clabernetesconstants.KubernetesCRIContainerd,
+			configManagerGetter: func() clabernetesconfig.Manager {
+				return clabernetesconfig.NewFakeManager(
+					clabernetesconfig.WithImageCacheHostPath("/var/lib/clabernetes/image-cache"),
+				)
+			},
+		},
+		{
+			name: "additional-status-probes",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+		   name: test
+		   topology:
+		     nodes:
+		       srl1:
+		         kind: srl
+		         image: ghcr.io/nokia/srlinux
+		`,
+					},
+					StatusProbes: clabernetesapisv1alpha1.StatusProbes{
+						Enabled: true,
+						ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+							HTTPProbeConfiguration: &clabernetesapisv1alpha1.HTTPProbeConfiguration{
+								Port:               443,
+								Path:               "/restconf/data",
+								Scheme:             "HTTPS",
+								ExpectedStatusCode: 401,
+							},
+							GNMIProbeConfiguration: &clabernetesapisv1alpha1.GNMIProbeConfiguration{
+								Port:     57400,
+								Username: "admin",
+								Password: "NokiaSrl1!",
+							},
+							NETCONFProbeConfiguration: &clabernetesapisv1alpha1.NETCONFProbeConfiguration{
+								Username: "admin",
+								Password: "NokiaSrl1!",
+							},
+							ExecProbeConfiguration: &clabernetesapisv1alpha1.ExecProbeConfiguration{
+								Command:        []string{"sr_cli", "show version"},
+								ExpectedOutput: "Software Version",
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName:            "srl1",
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
+		{
+			name: "ssh-probe-credentials-secret",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+		   name: test
+		   topology:
+		     nodes:
+		       srl1:
+		         kind: srl
+		         image: ghcr.io/nokia/srlinux
+		`,
+					},
+					StatusProbes: clabernetesapisv1alpha1.StatusProbes{
+						Enabled: true,
+						ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+							SSHProbeConfiguration: &clabernetesapisv1alpha1.SSHProbeConfiguration{
+								Username:          "admin",
+								CredentialsSecret: "srl-credentials",
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName:            "srl1",
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
+		{
+			name: "gnmi-netconf-probe-credentials-secret",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-deployment-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Definition: clabernetesapisv1alpha1.Definition{
+						Containerlab: `---
+		   name: test
+		   topology:
+		     nodes:
+		       srl1:
+		         kind: srl
+		         image: ghcr.io/nokia/srlinux
+		`,
+					},
+					StatusProbes: clabernetesapisv1alpha1.StatusProbes{
+						Enabled: true,
+						ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+							GNMIProbeConfiguration: &clabernetesapisv1alpha1.GNMIProbeConfiguration{
+								Port:              57400,
+								CredentialsSecret: "srl-credentials",
+							},
+							NETCONFProbeConfiguration: &clabernetesapisv1alpha1.NETCONFProbeConfiguration{
+								Username:          "admin",
+								CredentialsSecret: "srl-credentials",
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name:   "srl1",
+					Prefix: clabernetesutil.ToPointer(""),
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+							Ports: []string{},
+						},
+						Kinds: nil,
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:  "srl",
+								Image: "ghcr.io/nokia/srlinux",
+							},
+						},
+						Links: nil,
+					},
+					Debug: false,
+				},
+			},
+			nodeName:            "srl1",
+			configManagerGetter: clabernetesconfig.GetFakeManager,
+		},
 		{
 			name: "scheduling",
 			owningTopology: &clabernetesapisv1alpha1.Topology{
@@ -0,0 +1,180 @@
+package topology
+
+import (
+	"sort"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+	k8sappsv1 "k8s.io/api/apps/v1"
+	k8scorev1 "k8s.io/api/core/v1"
+)
+
+// recordTopologyStateChange emits an event on the Topology if the newly resolved topology state
+// differs from the state currently stored in the Topology status.
+func (r *Reconciler) recordTopologyStateChange(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+) {
+	previousState := owningTopology.Status.TopologyState
+
+	if reconcileData.TopologyState == previousState {
+		return
+	}
+
+	eventType := k8scorev1.EventTypeNormal
+
+	switch reconcileData.TopologyState { //nolint:exhaustive
+	case clabernetesapisv1alpha1.TopologyStateDegraded,
+		clabernetesapisv1alpha1.TopologyStateDeployFailed:
+		eventType = k8scorev1.EventTypeWarning
+	}
+
+	if previousState == "" {
+		r.Recorder.Eventf(
+			owningTopology,
+			nil,
+			eventType,
+			clabernetesconstants.EventReasonTopologyStateChanged,
+			clabernetesconstants.EventActionReconcile,
+			"topology state is now %q",
+			reconcileData.TopologyState,
+		)
+
+		return
+	}
+
+	r.Recorder.Eventf(
+		owningTopology,
+		nil,
+		eventType,
+		clabernetesconstants.EventReasonTopologyStateChanged,
+		clabernetesconstants.EventActionReconcile,
+		"topology state changed from %q to %q",
+		previousState,
+		reconcileData.TopologyState,
+	)
+}
+
+// recordNodeStatusChanges emits an event on the Topology, and on the launcher Deployment of the
+// node (if it exists), for every node whose status changed since the last reconciliation.
+func (r *Reconciler) recordNodeStatusChanges(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+	deployments *clabernetesutil.ObjectDiffer[*k8sappsv1.Deployment],
+) {
+	nodeNames := make([]string, 0, len(reconcileData.NodeStatuses))
+
+	for nodeName := range reconcileData.NodeStatuses {
+		nodeNames = append(nodeNames, nodeName)
+	}
+
+	sort.Strings(nodeNames)
+
+	for _, nodeName := range nodeNames {
+		status := reconcileData.NodeStatuses[nodeName]
+
+		previousStatus, ok := reconcileData.PreviousNodeStatuses[nodeName]
+		if ok && previousStatus == status {
+			continue
+		}
+
+		if !ok && status == clabernetesconstants.NodeStatusUnknown {
+			// brand new node that we've not deployed yet, not terribly interesting
+			continue
+		}
+
+		eventType := k8scorev1.EventTypeNormal
+		if status == clabernetesconstants.NodeStatusNotReady {
+			eventType = k8scorev1.EventTypeWarning
+		}
+
+		if previousStatus == "" {
+			previousStatus = clabernetesconstants.NodeStatusUnknown
+		}
+
+		deployment, hasDeployment := deployments.Current[nodeName]
+
+		if !hasDeployment {
+			r.Recorder.Eventf(
+				owningTopology,
+				nil,
+				eventType,
+				clabernetesconstants.EventReasonNodeStatusChanged,
+				clabernetesconstants.EventActionReconcile,
+				"node %q status changed from %q to %q",
+				nodeName,
+				previousStatus,
+				status,
+			)
+
+			continue
+		}
+
+		r.Recorder.Eventf(
+			owningTopology,
+			deployment,
+			eventType,
+			clabernetesconstants.EventReasonNodeStatusChanged,
+			clabernetesconstants.EventActionReconcile,
+			"node %q status changed from %q to %q",
+			nodeName,
+			previousStatus,
+			status,
+		)
+
+		r.Recorder.Eventf(
+			deployment,
+			owningTopology,
+			eventType,
+			clabernetesconstants.EventReasonNodeStatusChanged,
+			clabernetesconstants.EventActionReconcile,
+			"node status changed from %q to %q",
+			previousStatus,
+			status,
+		)
+	}
+}
+
+// recordNodeRestart emits an event on the Topology and the launcher Deployment of the node
+// indicating the node was (or failed to be) restarted due to a configuration change.
+func (r *Reconciler) recordNodeRestart(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	nodeDeployment *k8sappsv1.Deployment,
+	nodeName string,
+	restartErr error,
+) {
+	if restartErr != nil {
+		r.Recorder.Eventf(
+			owningTopology,
+			nodeDeployment,
+			k8scorev1.EventTypeWarning,
+			clabernetesconstants.EventReasonNodeRestartFailed,
+			clabernetesconstants.EventActionRestart,
+			"failed restarting node %q after configuration change, error: %s",
+			nodeName,
+			restartErr,
+		)
+
+		return
+	}
+
+	r.Recorder.Eventf(
+		owningTopology,
+		nodeDeployment,
+		k8scorev1.EventTypeNormal,
+		clabernetesconstants.EventReasonNodeRestarted,
+		clabernetesconstants.EventActionRestart,
+		"restarted node %q as its configuration changed",
+		nodeName,
+	)
+
+	r.Recorder.Eventf(
+		nodeDeployment,
+		owningTopology,
+		k8scorev1.EventTypeNormal,
+		clabernetesconstants.EventReasonNodeRestarted,
+		clabernetesconstants.EventActionRestart,
+		"restarted node as its configuration changed",
+	)
+}
@@ -0,0 +1,197 @@
+package topology
+
+import (
+	"maps"
+	"slices"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+	clabernetesutilkubernetes "github.com/srl-labs/clabernetes/util/kubernetes"
+	k8scorev1 "k8s.io/api/core/v1"
+	apimachinerymeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachinerylabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+)
+
+// NewImagePrewarmReconciler returns an instance of ImagePrewarmReconciler.
+func NewImagePrewarmReconciler(
+	log claberneteslogging.Instance,
+	configManagerGetter clabernetesconfig.ManagerGetterFunc,
+) *ImagePrewarmReconciler {
+	return &ImagePrewarmReconciler{
+		log:                 log,
+		configManagerGetter: configManagerGetter,
+	}
+}
+
+// ImagePrewarmReconciler is a subcomponent of the "TopologyReconciler" but is exposed for testing
+// purposes. This is the component responsible for rendering the ImageRequests that pre-pull
+// ("prewarm") the images of a topology onto the kubernetes nodes its launchers may run on.
+type ImagePrewarmReconciler struct {
+	log                 claberneteslogging.Instance
+	configManagerGetter clabernetesconfig.ManagerGetterFunc
+}
+
+// Enabled returns true if image prewarming is enabled for the given topology. Prewarming relies on
+// the image pull through machinery, so it is never enabled if pull through mode is "never".
+func (r *ImagePrewarmReconciler) Enabled(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+) bool {
+	imagePullThroughMode := owningTopology.Spec.ImagePull.PullThroughOverride
+	if imagePullThroughMode == "" {
+		imagePullThroughMode = r.configManagerGetter().GetImagePullThroughMode()
+	}
+
+	if imagePullThroughMode == clabernetesconstants.ImagePullThroughModeNever {
+		return false
+	}
+
+	return ResolveGlobalVsTopologyBool(
+		r.configManagerGetter().GetImagePullPrewarm(),
+		owningTopology.Spec.ImagePull.Prewarm,
+	)
+}
+
+// Images returns a map of the images of the given resolved (sub-topology) configs to the name of
+// the (first, by name) topology node using that image. Segment (bridge) nodes have no image so
+// they are skipped.
+func (r *ImagePrewarmReconciler) Images(
+	clabernetesConfigs map[string]*clabernetesutilcontainerlab.Config,
+) map[string]string {
+	images := make(map[string]string)
+
+	for _, nodeName := range slices.Sorted(maps.Keys(clabernetesConfigs)) {
+		subTopology := clabernetesConfigs[nodeName].Topology
+
+		if subTopology.IsSegmentNode(nodeName) {
+			continue
+		}
+
+		nodeImage := subTopology.GetNodeImage(nodeName)
+		if nodeImage == "" {
+			continue
+		}
+
+		_, ok := images[nodeImage]
+		if !ok {
+			images[nodeImage] = nodeName
+		}
+	}
+
+	return images
+}
+
+// CandidateNodes returns the (sorted) names of the given kubernetes nodes that launchers running
+// the given image could be scheduled on -- that is nodes that are schedulable, match the launcher
+// node selectors and do not have any (scheduling relevant) taints the launcher does not tolerate.
+func (r *ImagePrewarmReconciler) CandidateNodes(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	imageName string,
+	nodes []k8scorev1.Node,
+) []string {
+	nodeSelector := apimachinerylabels.SelectorFromSet(
+		ResolveNodeSelectors(r.configManagerGetter(), owningTopology, imageName),
+	)
+
+	candidateNodes := make([]string, 0)
+
+	for idx := range nodes {
+		node := &nodes[idx]
+
+		if node.Spec.Unschedulable {
+			continue
+		}
+
+		if !nodeSelector.Matches(apimachinerylabels.Set(node.Labels)) {
+			continue
+		}
+
+		if !toleratesNodeTaints(
+			owningTopology.Spec.Deployment.Scheduling.Tolerations,
+			node.Spec.Taints,
+		) {
+			continue
+		}
+
+		candidateNodes = append(candidateNodes, node.Name)
+	}
+
+	slices.Sort(candidateNodes)
+
+	return candidateNodes
+}
+
+func toleratesNodeTaints(tolerations []k8scorev1.Toleration, taints []k8scorev1.Taint) bool {
+	for taintIdx := range taints {
+		taint := &taints[taintIdx]
+
+		if taint.Effect != k8scorev1.TaintEffectNoSchedule &&
+			taint.Effect != k8scorev1.TaintEffectNoExecute {
+			// "PreferNoSchedule" does not keep a launcher off the node
+			continue
+		}
+
+		tolerated := slices.ContainsFunc(
+			tolerations,
+			func(toleration k8scorev1.Toleration) bool {
+				return toleration.ToleratesTaint(klog.Background(), taint, false)
+			},
+		)
+		if !tolerated {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Render accepts the owning topology, the name of a topology node running the given image and the
+// kubernetes node to pull the image onto and renders the ImageRequest to do so. The ImageRequest
+// is named the same way the launchers name their requests so prewarming and launchers share the
+// request rather than pulling the image twice.
+func (r *ImagePrewarmReconciler) Render(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	topologyNodeName,
+	kubernetesNode,
+	imageName string,
+) *clabernetesapisv1alpha1.ImageRequest {
+	return &clabernetesapisv1alpha1.ImageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clabernetesutilkubernetes.ImageRequestName(kubernetesNode, imageName),
+			Namespace: owningTopology.GetNamespace(),
+			Labels: map[string]string{
+				clabernetesconstants.LabelApp:           clabernetesconstants.Clabernetes,
+				clabernetesconstants.LabelTopologyOwner: owningTopology.GetName(),
+			},
+		},
+		Spec: clabernetesapisv1alpha1.ImageRequestSpec{
+			TopologyName:              owningTopology.GetName(),
+			TopologyNodeName:          topologyNodeName,
+			KubernetesNode:            kubernetesNode,
+			RequestedImage:            imageName,
+			RequestedImagePullSecrets: owningTopology.Spec.ImagePull.PullSecrets,
+		},
+	}
+}
+
+// State returns the prewarm state for the given ImageRequest.
+func (r *ImagePrewarmReconciler) State(
+	imageRequest *clabernetesapisv1alpha1.ImageRequest,
+) string {
+	if imageRequest.Status.Complete {
+		return clabernetesconstants.ImagePrewarmStatePulled
+	}
+
+	if apimachinerymeta.IsStatusConditionFalse(
+		imageRequest.Status.Conditions,
+		clabernetesconstants.ImageRequestPulledCondition,
+	) {
+		return clabernetesconstants.ImagePrewarmStateFailed
+	}
+
+	return clabernetesconstants.ImagePrewarmStatePulling
+}
@@ -0,0 +1,192 @@
+package topology_test
+
+import (
+	"reflect"
+	"testing"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestImagePrewarmImages(t *testing.T) {
+	reconciler := clabernetescontrollerstopology.NewImagePrewarmReconciler(
+		&claberneteslogging.FakeInstance{},
+		clabernetesconfig.GetFakeManager,
+	)
+
+	clabernetesConfigs := map[string]*clabernetesutilcontainerlab.Config{
+		"srl2": {
+			Topology: &clabernetesutilcontainerlab.Topology{
+				Defaults: &clabernetesutilcontainerlab.NodeDefinition{},
+				Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+					"srl2": {Kind: "srl", Image: "ghcr.io/nokia/srlinux"},
+				},
+			},
+		},
+		"srl1": {
+			Topology: &clabernetesutilcontainerlab.Topology{
+				Defaults: &clabernetesutilcontainerlab.NodeDefinition{},
+				Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+					"srl1": {Kind: "srl", Image: "ghcr.io/nokia/srlinux"},
+				},
+			},
+		},
+		"ceos1": {
+			Topology: &clabernetesutilcontainerlab.Topology{
+				Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+					Image: "ceos:4.32",
+				},
+				Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+					"ceos1": {Kind: "ceos"},
+				},
+			},
+		},
+		"br1": {
+			Topology: &clabernetesutilcontainerlab.Topology{
+				Defaults: &clabernetesutilcontainerlab.NodeDefinition{},
+				Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+					"br1": {Kind: "bridge"},
+				},
+			},
+		},
+	}
+
+	expected := map[string]string{
+		"ghcr.io/nokia/srlinux": "srl1",
+		"ceos:4.32":             "ceos1",
+	}
+
+	actual := reconciler.Images(clabernetesConfigs)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected images %v, got %v", expected, actual)
+	}
+}
+
+func TestImagePrewarmCandidateNodes(t *testing.T) {
+	nodes := []k8scorev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "worker-1",
+				Labels: map[string]string{"node-flavour": "beefy"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "worker-2",
+				Labels: map[string]string{"node-flavour": "wimpy"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "worker-3",
+				Labels: map[string]string{"node-flavour": "beefy"},
+			},
+			Spec: k8scorev1.NodeSpec{
+				Unschedulable: true,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "control-plane",
+				Labels: map[string]string{"node-flavour": "beefy"},
+			},
+			Spec: k8scorev1.NodeSpec{
+				Taints: []k8scorev1.Taint{
+					{
+						Key:    "node-role.kubernetes.io/control-plane",
+						Effect: k8scorev1.TaintEffectNoSchedule,
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "worker-4",
+				Labels: map[string]string{"node-flavour": "wimpy"},
+			},
+			Spec: k8scorev1.NodeSpec{
+				Taints: []k8scorev1.Taint{
+					{
+						Key:    "busy",
+						Effect: k8scorev1.TaintEffectPreferNoSchedule,
+					},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		name                 string
+		imageName            string
+		nodeSelector         map[string]string
+		tolerations          []k8scorev1.Toleration
+		nodeSelectorsByImage map[string]map[string]string
+		expected             []string
+	}{
+		{
+			name:      "no-constraints",
+			imageName: "ghcr.io/nokia/srlinux",
+			expected:  []string{"worker-1", "worker-2", "worker-4"},
+		},
+		{
+			name:         "topology-node-selector",
+			imageName:    "ghcr.io/nokia/srlinux",
+			nodeSelector: map[string]string{"node-flavour": "beefy"},
+			expected:     []string{"worker-1"},
+		},
+		{
+			name:         "tolerations",
+			imageName:    "ghcr.io/nokia/srlinux",
+			nodeSelector: map[string]string{"node-flavour": "beefy"},
+			tolerations: []k8scorev1.Toleration{
+				{
+					Key:      "node-role.kubernetes.io/control-plane",
+					Operator: k8scorev1.TolerationOpExists,
+					Effect:   k8scorev1.TaintEffectNoSchedule,
+				},
+			},
+			expected: []string{"control-plane", "worker-1"},
+		},
+		{
+			name:         "node-selectors-by-image",
+			imageName:    "ghcr.io/nokia/srlinux",
+			nodeSelector: map[string]string{"node-flavour": "beefy"},
+			nodeSelectorsByImage: map[string]map[string]string{
+				"ghcr.io/nokia/srlinux*": {"node-flavour": "wimpy"},
+			},
+			expected: []string{"worker-2", "worker-4"},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				reconciler := clabernetescontrollerstopology.NewImagePrewarmReconciler(
+					&claberneteslogging.FakeInstance{},
+					func() clabernetesconfig.Manager {
+						return clabernetesconfig.NewFakeManager(
+							clabernetesconfig.WithNodeSelectors(testCase.nodeSelectorsByImage),
+						)
+					},
+				)
+
+				owningTopology := &clabernetesapisv1alpha1.Topology{}
+				owningTopology.Spec.Deployment.Scheduling.NodeSelector = testCase.nodeSelector
+				owningTopology.Spec.Deployment.Scheduling.Tolerations = testCase.tolerations
+
+				actual := reconciler.CandidateNodes(owningTopology, testCase.imageName, nodes)
+				if !reflect.DeepEqual(actual, testCase.expected) {
+					t.Fatalf("expected candidate nodes %v, got %v", testCase.expected, actual)
+				}
+			},
+		)
+	}
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 
 	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetesmetrics "github.com/srl-labs/clabernetes/metrics"
+	k8scorev1 "k8s.io/api/core/v1"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	ctrlruntime "sigs.k8s.io/controller-runtime"
+	ctrlruntimeutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 // Reconcile handles reconciliation for this controller.
@@ -30,19 +34,31 @@ func (c *Controller) Reconcile(
 	}
 
 	if topology.DeletionTimestamp != nil {
-		return ctrlruntime.Result{}, nil
+		return c.reconcileTeardown(ctx, topology)
 	}
 
 	if c.BaseController.ShouldIgnoreReconcile(topology) {
 		return ctrlruntime.Result{}, nil
 	}
 
+	if !ctrlruntimeutil.ContainsFinalizer(
+		topology,
+		clabernetesconstants.TopologyTeardownFinalizer,
+	) {
+		// the update will trigger another reconcile, so we are done with this one
+		ctrlruntimeutil.AddFinalizer(topology, clabernetesconstants.TopologyTeardownFinalizer)
+
+		return ctrlruntime.Result{}, c.BaseController.Client.Update(ctx, topology)
+	}
+
 	// we always reconcile the "namespace" resources first -- meaning the resources that exist in
 	// the namespace that are not 1:1 to a Topology -- for example: service account and role
 	// binding. These resources are created for the namespace on creation of the first Topology in
 	// the namespace, and are removed when the last Topology is removed from the namespace.
 	err = c.TopologyReconciler.ReconcileNamespaceResources(ctx, topology)
 	if err != nil {
+		clabernetesmetrics.RecordReconcileError("namespaceresources")
+
 		return ctrlruntime.Result{}, err
 	}
 
@@ -52,6 +68,18 @@ func (c *Controller) Reconcile(
 			"failed processing previously stored containerlab resource, error: %s", err,
 		)
 
+		clabernetesmetrics.RecordReconcileError("reconciledata")
+
+		c.Recorder.Eventf(
+			topology,
+			nil,
+			k8scorev1.EventTypeWarning,
+			clabernetesconstants.EventReasonDefinitionInvalid,
+			clabernetesconstants.EventActionProcessDefinition,
+			"failed processing previously stored topology data, error: %s",
+			err,
+		)
+
 		return ctrlruntime.Result{}, err
 	}
 
@@ -62,6 +90,41 @@ func (c *Controller) Reconcile(
 	if err != nil {
 		c.BaseController.Log.Criticalf("failed processing topology definition, error: %s", err)
 
+		clabernetesmetrics.RecordReconcileError("definition")
+
+		c.Recorder.Eventf(
+			topology,
+			nil,
+			k8scorev1.EventTypeWarning,
+			clabernetesconstants.EventReasonDefinitionInvalid,
+			clabernetesconstants.EventActionProcessDefinition,
+			"failed processing topology definition, error: %s",
+			err,
+		)
+
+		return ctrlruntime.Result{}, err
+	}
+
+	err = c.TopologyReconciler.ReconcileSnapshotRestore(ctx, topology, reconcileData)
+	if err != nil {
+		c.BaseController.Log.Criticalf(
+			"failed restoring topology from snapshot, error: %s",
+			err,
+		)
+
+		clabernetesmetrics.RecordReconcileError("restore")
+
+		c.Recorder.Eventf(
+			topology,
+			nil,
+			k8scorev1.EventTypeWarning,
+			clabernetesconstants.EventReasonSnapshotRestoreFailed,
+			clabernetesconstants.EventActionRestore,
+			"failed restoring node configurations from topology snapshot %q, error: %s",
+			topology.Spec.Deployment.RestoreFromSnapshot,
+			err,
+		)
+
 		return ctrlruntime.Result{}, err
 	}
 
@@ -82,6 +145,8 @@ func (c *Controller) Reconcile(
 				err,
 			)
 
+			clabernetesmetrics.RecordReconcileError("status")
+
 			return ctrlruntime.Result{}, err
 		}
 
@@ -94,6 +159,8 @@ func (c *Controller) Reconcile(
 				err,
 			)
 
+			clabernetesmetrics.RecordReconcileError("status")
+
 			return ctrlruntime.Result{}, err
 		}
 	}
@@ -119,6 +186,24 @@ func (c *Controller) reconcileResources(
 			err,
 		)
 
+		clabernetesmetrics.RecordReconcileError("configmap")
+
+		return err
+	}
+
+	err = c.TopologyReconciler.ReconcileWireGuard(
+		ctx,
+		topology,
+		reconcileData,
+	)
+	if err != nil {
+		c.BaseController.Log.Criticalf(
+			"failed reconciling clabernetes wireguard secret, error: %s",
+			err,
+		)
+
+		clabernetesmetrics.RecordReconcileError("wireguard")
+
 		return err
 	}
 
@@ -133,6 +218,8 @@ func (c *Controller) reconcileResources(
 			err,
 		)
 
+		clabernetesmetrics.RecordReconcileError("connectivity")
+
 		return err
 	}
 
@@ -154,6 +241,21 @@ func (c *Controller) reconcileResources(
 	if err != nil {
 		c.BaseController.Log.Criticalf("failed reconciling clabernetes pvcs, error: %s", err)
 
+		clabernetesmetrics.RecordReconcileError("persistentvolumeclaim")
+
+		return err
+	}
+
+	err = c.TopologyReconciler.ReconcileImagePrewarm(
+		ctx,
+		topology,
+		reconcileData,
+	)
+	if err != nil {
+		c.BaseController.Log.Criticalf("failed reconciling image prewarm, error: %s", err)
+
+		clabernetesmetrics.RecordReconcileError("imageprewarm")
+
 		return err
 	}
 
@@ -165,6 +267,8 @@ func (c *Controller) reconcileResources(
 	if err != nil {
 		c.BaseController.Log.Criticalf("failed reconciling clabernetes deployments, error: %s", err)
 
+		clabernetesmetrics.RecordReconcileError("deployments")
+
 		return err
 	}
 
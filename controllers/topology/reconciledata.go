@@ -23,12 +23,17 @@ type ReconcileData struct {
 
 	ResolvedExposedPorts map[string]*clabernetesapisv1alpha1.ExposedPorts
 
+	RestoreFilesFromConfigMap map[string][]clabernetesapisv1alpha1.FileFromConfigMap
+
 	PreviousNodeStatuses map[string]string
 	NodeStatuses         map[string]string
 	TopologyReady        bool
 
 	TopologyState     clabernetesapisv1alpha1.TopologyState
 	NodeProbeStatuses map[string]clabernetesapisv1alpha1.NodeProbeStatuses
+	NodeRestarts      map[string]int
+
+	ImagePrewarm map[string]map[string]string
 
 	NodesNeedingReboot clabernetesutil.StringSet
 
@@ -54,9 +59,14 @@ func NewReconcileData(
 
 		ResolvedExposedPorts: map[string]*clabernetesapisv1alpha1.ExposedPorts{},
 
+		RestoreFilesFromConfigMap: make(
+			map[string][]clabernetesapisv1alpha1.FileFromConfigMap,
+		),
+
 		PreviousNodeStatuses: owningTopology.Status.NodeReadiness,
 		NodeStatuses:         make(map[string]string),
 		NodeProbeStatuses:    make(map[string]clabernetesapisv1alpha1.NodeProbeStatuses),
+		NodeRestarts:         make(map[string]int),
 		NodesNeedingReboot:   clabernetesutil.NewStringSet(),
 	}
 
@@ -98,6 +108,8 @@ func (r *ReconcileData) SetStatus(
 	owningTopologyStatus.TopologyReady = r.TopologyReady
 	owningTopologyStatus.TopologyState = r.TopologyState
 	owningTopologyStatus.NodeProbeStatuses = r.NodeProbeStatuses
+	owningTopologyStatus.NodeRestarts = r.NodeRestarts
+	owningTopologyStatus.ImagePrewarm = r.ImagePrewarm
 
 	return nil
 }
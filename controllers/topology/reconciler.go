@@ -3,8 +3,11 @@ package topology
 import (
 	"context"
 	"fmt"
+	"maps"
 	"reflect"
 	"slices"
+	"strconv"
+	"sync"
 	"time"
 
 	clabernetesapis "github.com/srl-labs/clabernetes/apis"
@@ -13,13 +16,16 @@ import (
 	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
 	claberneteserrors "github.com/srl-labs/clabernetes/errors"
 	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	clabernetesmetrics "github.com/srl-labs/clabernetes/metrics"
 	clabernetesutil "github.com/srl-labs/clabernetes/util"
 	k8sappsv1 "k8s.io/api/apps/v1"
 	k8scorev1 "k8s.io/api/core/v1"
+	k8sdiscoveryv1 "k8s.io/api/discovery/v1"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	apimachinerymeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	clientgoevents "k8s.io/client-go/tools/events"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntimeutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -29,13 +35,20 @@ import (
 // common/standard resources that represent a clabernetes object (configmap, deployments,
 // services, etc.).
 type Reconciler struct {
-	Log    claberneteslogging.Instance
-	Client ctrlruntimeclient.Client
+	Log      claberneteslogging.Instance
+	Client   ctrlruntimeclient.Client
+	Recorder clientgoevents.EventRecorder
 
-	serviceAccountReconciler *ServiceAccountReconciler
-	roleBindingReconciler    *RoleBindingReconciler
-	configMapReconciler      *ConfigMapReconciler
-	connectivityReconciler   *ConnectivityReconciler
+	serviceAccountReconciler  *ServiceAccountReconciler
+	roleBindingReconciler     *RoleBindingReconciler
+	configMapReconciler       *ConfigMapReconciler
+	connectivityReconciler    *ConnectivityReconciler
+	wireGuardSecretReconciler *WireGuardSecretReconciler
+	imagePrewarmReconciler    *ImagePrewarmReconciler
+
+	// deployingSince holds the time each topology (by uid) entered the "deploying" state so the
+	// time spent deploying can be recorded once the topology is running.
+	deployingSince sync.Map
 
 	// these ones are exposed for testing purposes. no reason to not expose them really anyway so
 	// no big deal. not exposing the others at this point since there isnt a reason to (yet, but
@@ -50,14 +63,16 @@ type Reconciler struct {
 func NewReconciler(
 	log claberneteslogging.Instance,
 	client ctrlruntimeclient.Client,
+	recorder clientgoevents.EventRecorder,
 	managerAppName,
 	managerNamespace,
 	criKind string,
 	configManagerGetter clabernetesconfig.ManagerGetterFunc,
 ) *Reconciler {
 	return &Reconciler{
-		Log:    log,
-		Client: client,
+		Log:      log,
+		Client:   client,
+		Recorder: recorder,
 		serviceAccountReconciler: NewServiceAccountReconciler(
 			log,
 			client,
@@ -77,6 +92,14 @@ func NewReconciler(
 			log,
 			configManagerGetter,
 		),
+		wireGuardSecretReconciler: NewWireGuardSecretReconciler(
+			log,
+			configManagerGetter,
+		),
+		imagePrewarmReconciler: NewImagePrewarmReconciler(
+			log,
+			configManagerGetter,
+		),
 		ServiceFabricReconciler: NewServiceFabricReconciler(
 			log,
 			configManagerGetter,
@@ -270,6 +293,74 @@ func (r *Reconciler) ReconcileConfigMap(
 	return r.updateObj(ctx, renderedConfigMap, clabernetesconstants.KubernetesConfigMap)
 }
 
+// ReconcileWireGuard reconciles the secret holding the wireguard keys (and addresses) of the
+// launchers of the topology and sets the resulting wireguard peering information on the resolved
+// tunnels. This is a no-op for topologies not using "wireguard" connectivity.
+func (r *Reconciler) ReconcileWireGuard(
+	ctx context.Context,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+) error {
+	if owningTopology.Spec.Connectivity != clabernetesconstants.ConnectivityWireGuard {
+		return nil
+	}
+
+	namespacedName := apimachinerytypes.NamespacedName{
+		Namespace: owningTopology.GetNamespace(),
+		Name:      WireGuardSecretName(owningTopology),
+	}
+
+	existingSecret := &k8scorev1.Secret{}
+
+	err := r.getObj(ctx, existingSecret, namespacedName, clabernetesconstants.KubernetesSecret)
+	if err != nil && !apimachineryerrors.IsNotFound(err) {
+		return err
+	}
+
+	secretExists := err == nil
+
+	secretData, err := RenderWireGuardSecretData(
+		existingSecret.Data,
+		slices.Sorted(maps.Keys(reconcileData.ResolvedConfigs)),
+	)
+	if err != nil {
+		return err
+	}
+
+	renderedSecret := r.wireGuardSecretReconciler.Render(owningTopology, secretData)
+
+	err = ResolveWireGuardTunnels(reconcileData.ResolvedTunnels, secretData)
+	if err != nil {
+		return err
+	}
+
+	if !secretExists {
+		return r.createObj(
+			ctx,
+			owningTopology,
+			renderedSecret,
+			clabernetesconstants.KubernetesSecret,
+		)
+	}
+
+	if r.wireGuardSecretReconciler.Conforms(
+		existingSecret,
+		renderedSecret,
+		owningTopology.GetUID(),
+	) {
+		return nil
+	}
+
+	renderedSecret.ResourceVersion = existingSecret.ResourceVersion
+
+	err = ctrlruntimeutil.SetOwnerReference(owningTopology, renderedSecret, r.Client.Scheme())
+	if err != nil {
+		return err
+	}
+
+	return r.updateObj(ctx, renderedSecret, clabernetesconstants.KubernetesSecret)
+}
+
 // ReconcileConnectivity reconciles the inter-launcher-pod connectivity cr for the topology.
 func (r *Reconciler) ReconcileConnectivity(
 	ctx context.Context,
@@ -297,13 +388,32 @@ func (r *Reconciler) ReconcileConnectivity(
 		return err
 	}
 
-	AllocateTunnelIDs(
+	allocatedTunnelIDs := AllocateTunnelIDs(
 		// we either have an empty object because we didnt find it, or we have the previous tunnels
 		// either way, we can now allocate tunnel ids
 		existingConnectivity.Spec.PointToPointTunnels,
 		reconcileData.ResolvedTunnels,
 	)
 
+	if apimachinerymeta.SetStatusCondition(
+		&owningTopology.Status.Conditions,
+		DataplaneCondition(reconcileData.ResolvedTunnels, &existingConnectivity.Status),
+	) {
+		reconcileData.ShouldUpdateResource = true
+	}
+
+	if allocatedTunnelIDs > 0 {
+		r.Recorder.Eventf(
+			owningTopology,
+			nil,
+			k8scorev1.EventTypeNormal,
+			clabernetesconstants.EventReasonTunnelsAllocated,
+			clabernetesconstants.EventActionAllocate,
+			"allocated %d new tunnel id(s)",
+			allocatedTunnelIDs,
+		)
+	}
+
 	if err != nil {
 		// get error was not found, we need to create
 		return r.createObj(
@@ -347,6 +457,8 @@ func (r *Reconciler) ReconcileServices(
 			"failed reconciling clabernetes fabric services, error: %s", err,
 		)
 
+		clabernetesmetrics.RecordReconcileError("servicefabric")
+
 		return err
 	}
 
@@ -360,6 +472,23 @@ func (r *Reconciler) ReconcileServices(
 			"failed reconciling clabernetes expose services, error: %s", err,
 		)
 
+		clabernetesmetrics.RecordReconcileError("servicesexpose")
+
+		return err
+	}
+
+	err = r.ReconcileServiceSharedExpose(
+		ctx,
+		owningTopology,
+		reconcileData,
+	)
+	if err != nil {
+		r.Log.Criticalf(
+			"failed reconciling clabernetes shared expose service, error: %s", err,
+		)
+
+		clabernetesmetrics.RecordReconcileError("servicesharedexpose")
+
 		return err
 	}
 
@@ -578,6 +707,295 @@ func (r *Reconciler) ReconcileServicesExpose(
 	return nil
 }
 
+// ReconcileServiceSharedExpose reconciles the shared load balancer service (and the endpoint
+// slices backing it) used for exposing nodes with the "SharedLoadBalancer" expose type. For any
+// other expose type this only makes sure no shared service/endpoint slices are left behind.
+func (r *Reconciler) ReconcileServiceSharedExpose(
+	ctx context.Context,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+) error {
+	serviceTypeName := fmt.Sprintf("shared expose %s", clabernetesconstants.KubernetesService)
+	serviceType := clabernetesconstants.TopologyServiceTypeSharedExpose
+
+	sharedExposeLabels := ctrlruntimeclient.MatchingLabels{
+		clabernetesconstants.LabelTopologyOwner:       owningTopology.GetName(),
+		clabernetesconstants.LabelTopologyServiceType: serviceType,
+	}
+
+	existingServices := &k8scorev1.ServiceList{}
+
+	err := r.Client.List(
+		ctx,
+		existingServices,
+		ctrlruntimeclient.InNamespace(owningTopology.GetNamespace()),
+		sharedExposeLabels,
+	)
+	if err != nil {
+		return err
+	}
+
+	existingEndpointSlices := &k8sdiscoveryv1.EndpointSliceList{}
+
+	err = r.Client.List(
+		ctx,
+		existingEndpointSlices,
+		ctrlruntimeclient.InNamespace(owningTopology.GetNamespace()),
+		sharedExposeLabels,
+	)
+	if err != nil {
+		return err
+	}
+
+	if owningTopology.Spec.Expose.DisableExpose ||
+		owningTopology.Spec.Expose.ExposeType != exposeTypeSharedLoadBalancer {
+		for idx := range existingEndpointSlices.Items {
+			err = r.deleteObj(
+				ctx,
+				&existingEndpointSlices.Items[idx],
+				clabernetesconstants.KubernetesEndpointSlice,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		for idx := range existingServices.Items {
+			err = r.deleteObj(ctx, &existingServices.Items[idx], serviceTypeName)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	renderedService := r.ServiceExposeReconciler.RenderShared(owningTopology, reconcileData)
+
+	var existingService *k8scorev1.Service
+
+	for idx := range existingServices.Items {
+		if existingServices.Items[idx].GetName() == renderedService.GetName() {
+			existingService = &existingServices.Items[idx]
+
+			continue
+		}
+
+		err = r.deleteObj(ctx, &existingServices.Items[idx], serviceTypeName)
+		if err != nil {
+			return err
+		}
+	}
+
+	if existingService == nil {
+		err = r.createObj(ctx, owningTopology, renderedService, serviceTypeName)
+		if err != nil {
+			return err
+		}
+	} else {
+		if len(existingService.Status.LoadBalancer.Ingress) == 1 {
+			address := existingService.Status.LoadBalancer.Ingress[0].IP
+			if address != "" {
+				for _, exposedPorts := range reconcileData.ResolvedExposedPorts {
+					exposedPorts.LoadBalancerAddress = address
+				}
+			}
+		}
+
+		err = ctrlruntimeutil.SetOwnerReference(owningTopology, renderedService, r.Client.Scheme())
+		if err != nil {
+			return err
+		}
+
+		if !r.ServiceExposeReconciler.Conforms(
+			existingService,
+			renderedService,
+			owningTopology.GetUID(),
+		) {
+			renderedService.ResourceVersion = existingService.ResourceVersion
+
+			err = r.updateObj(ctx, renderedService, serviceTypeName)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = r.reconcileSharedExposeEndpointSlices(
+		ctx,
+		owningTopology,
+		reconcileData,
+		renderedService,
+		existingEndpointSlices,
+	)
+	if err != nil {
+		return err
+	}
+
+	for nodeName, exposedPorts := range reconcileData.ResolvedExposedPorts {
+		previousExposedPorts := owningTopology.Status.ExposedPorts[nodeName]
+
+		if previousExposedPorts == nil ||
+			previousExposedPorts.LoadBalancerAddress != exposedPorts.LoadBalancerAddress ||
+			!maps.Equal(previousExposedPorts.ExternalPorts, exposedPorts.ExternalPorts) {
+			reconcileData.ShouldUpdateResource = true
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) reconcileSharedExposeEndpointSlices(
+	ctx context.Context,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+	renderedService *k8scorev1.Service,
+	existingEndpointSlices *k8sdiscoveryv1.EndpointSliceList,
+) error {
+	launcherPods, err := r.getLauncherPods(ctx, owningTopology)
+	if err != nil {
+		return err
+	}
+
+	renderedEndpointSlices := r.ServiceExposeReconciler.RenderSharedEndpointSlices(
+		owningTopology,
+		reconcileData,
+		renderedService,
+		launcherPods,
+	)
+
+	existingByName := make(map[string]*k8sdiscoveryv1.EndpointSlice)
+
+	for idx := range existingEndpointSlices.Items {
+		existingEndpointSlice := &existingEndpointSlices.Items[idx]
+
+		existingByName[existingEndpointSlice.GetName()] = existingEndpointSlice
+	}
+
+	for _, renderedEndpointSlice := range renderedEndpointSlices {
+		existingEndpointSlice, ok := existingByName[renderedEndpointSlice.GetName()]
+
+		delete(existingByName, renderedEndpointSlice.GetName())
+
+		if ok && existingEndpointSlice.AddressType != renderedEndpointSlice.AddressType {
+			// address type is immutable, so the endpoint slice has to be replaced
+			err = r.deleteObj(
+				ctx,
+				existingEndpointSlice,
+				clabernetesconstants.KubernetesEndpointSlice,
+			)
+			if err != nil {
+				return err
+			}
+
+			ok = false
+		}
+
+		if !ok {
+			err = r.createObj(
+				ctx,
+				owningTopology,
+				renderedEndpointSlice,
+				clabernetesconstants.KubernetesEndpointSlice,
+			)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if r.ServiceExposeReconciler.EndpointSliceConforms(
+			existingEndpointSlice,
+			renderedEndpointSlice,
+			owningTopology.GetUID(),
+		) {
+			continue
+		}
+
+		err = ctrlruntimeutil.SetOwnerReference(
+			owningTopology,
+			renderedEndpointSlice,
+			r.Client.Scheme(),
+		)
+		if err != nil {
+			return err
+		}
+
+		renderedEndpointSlice.ResourceVersion = existingEndpointSlice.ResourceVersion
+
+		err = r.updateObj(
+			ctx,
+			renderedEndpointSlice,
+			clabernetesconstants.KubernetesEndpointSlice,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, extraEndpointSlice := range existingByName {
+		err = r.deleteObj(ctx, extraEndpointSlice, clabernetesconstants.KubernetesEndpointSlice)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getLauncherPods returns a mapping of node name to the (non-terminating) launcher pod of that
+// node for the given topology.
+func (r *Reconciler) getLauncherPods(
+	ctx context.Context,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+) (map[string]*k8scorev1.Pod, error) {
+	podList := &k8scorev1.PodList{}
+
+	err := r.Client.List(
+		ctx,
+		podList,
+		ctrlruntimeclient.InNamespace(owningTopology.GetNamespace()),
+		ctrlruntimeclient.MatchingLabels{
+			clabernetesconstants.LabelTopologyOwner: owningTopology.GetName(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	launcherPods := make(map[string]*k8scorev1.Pod)
+
+	for idx := range podList.Items {
+		pod := &podList.Items[idx]
+
+		nodeName, ok := pod.Labels[clabernetesconstants.LabelTopologyNode]
+		if !ok || pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		launcherName := fmt.Sprintf("%s-%s", owningTopology.GetName(), nodeName)
+
+		if ResolveTopologyRemovePrefix(owningTopology) {
+			launcherName = nodeName
+		}
+
+		if pod.Labels[clabernetesconstants.LabelName] != launcherName {
+			// not a launcher pod, i.e. an image puller pod for the node
+			continue
+		}
+
+		existingPod, ok := launcherPods[nodeName]
+		if ok && existingPod.Status.PodIP != "" {
+			continue
+		}
+
+		launcherPods[nodeName] = pod
+	}
+
+	return launcherPods, nil
+}
+
 // ReconcilePersistentVolumeClaim reconciles the persistent volume claims used for persisting the
 // containerlab working directory on nodes in a topology.
 func (r *Reconciler) ReconcilePersistentVolumeClaim(
@@ -667,6 +1085,134 @@ func (r *Reconciler) ReconcilePersistentVolumeClaim(
 	return nil
 }
 
+// ReconcileImagePrewarm reconciles the ImageRequests that pre-pull the topology images onto the
+// kubernetes nodes the launchers may be scheduled on, and records the progress of each of those in
+// the reconcile data. This is a no-op for topologies that do not have image prewarming enabled.
+func (r *Reconciler) ReconcileImagePrewarm(
+	ctx context.Context,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+) error {
+	if !r.imagePrewarmReconciler.Enabled(owningTopology) {
+		if owningTopology.Status.ImagePrewarm != nil {
+			// prewarming was disabled, clear out the stale progress
+			reconcileData.ShouldUpdateResource = true
+		}
+
+		return nil
+	}
+
+	nodes := &k8scorev1.NodeList{}
+
+	err := r.Client.List(ctx, nodes)
+	if err != nil {
+		return err
+	}
+
+	for imageName, topologyNodeName := range r.imagePrewarmReconciler.Images(
+		reconcileData.ResolvedConfigs,
+	) {
+		for _, kubernetesNode := range r.imagePrewarmReconciler.CandidateNodes(
+			owningTopology,
+			imageName,
+			nodes.Items,
+		) {
+			state, err := r.reconcileImagePrewarmRequest(
+				ctx,
+				owningTopology,
+				topologyNodeName,
+				kubernetesNode,
+				imageName,
+			)
+			if err != nil {
+				return err
+			}
+
+			if reconcileData.ImagePrewarm == nil {
+				reconcileData.ImagePrewarm = make(map[string]map[string]string)
+			}
+
+			if reconcileData.ImagePrewarm[imageName] == nil {
+				reconcileData.ImagePrewarm[imageName] = make(map[string]string)
+			}
+
+			reconcileData.ImagePrewarm[imageName][kubernetesNode] = state
+		}
+	}
+
+	if !reflect.DeepEqual(reconcileData.ImagePrewarm, owningTopology.Status.ImagePrewarm) {
+		reconcileData.ShouldUpdateResource = true
+	}
+
+	return nil
+}
+
+func (r *Reconciler) reconcileImagePrewarmRequest(
+	ctx context.Context,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	topologyNodeName,
+	kubernetesNode,
+	imageName string,
+) (string, error) {
+	previousState := owningTopology.Status.ImagePrewarm[imageName][kubernetesNode]
+	if previousState == clabernetesconstants.ImagePrewarmStatePulled ||
+		previousState == clabernetesconstants.ImagePrewarmStateFailed {
+		// done one way or another -- the image request itself is cleaned up once its ttl passes
+		// so there is nothing left to check
+		return previousState, nil
+	}
+
+	renderedImageRequest := r.imagePrewarmReconciler.Render(
+		owningTopology,
+		topologyNodeName,
+		kubernetesNode,
+		imageName,
+	)
+
+	existingImageRequest := &clabernetesapisv1alpha1.ImageRequest{}
+
+	err := r.getObj(
+		ctx,
+		existingImageRequest,
+		apimachinerytypes.NamespacedName{
+			Namespace: renderedImageRequest.GetNamespace(),
+			Name:      renderedImageRequest.GetName(),
+		},
+		clabernetesconstants.KubernetesImageRequest,
+	)
+	if err == nil {
+		return r.imagePrewarmReconciler.State(existingImageRequest), nil
+	}
+
+	if !apimachineryerrors.IsNotFound(err) {
+		return "", err
+	}
+
+	r.Log.Debugf(
+		"creating %s '%s/%s'",
+		clabernetesconstants.KubernetesImageRequest,
+		renderedImageRequest.GetNamespace(),
+		renderedImageRequest.GetName(),
+	)
+
+	// no owner reference here -- the image request is shared with any launcher (of any topology)
+	// wanting the same image on the same node, and is cleaned up by the image request controller
+	err = r.Client.Create(ctx, renderedImageRequest)
+	if err != nil && !apimachineryerrors.IsAlreadyExists(err) {
+		r.Log.Criticalf(
+			"failed creating %s '%s/%s' error: %s",
+			clabernetesconstants.KubernetesImageRequest,
+			renderedImageRequest.GetNamespace(),
+			renderedImageRequest.GetName(),
+			err,
+		)
+
+		return "", err
+	}
+
+	return clabernetesconstants.ImagePrewarmStatePulling, nil
+}
+
 // ReconcileDeployments reconciles the deployments that make up a clabernetes Topology.
 func (r *Reconciler) ReconcileDeployments( //nolint: gocyclo,gocognit,funlen
 	ctx context.Context,
@@ -713,8 +1259,15 @@ func (r *Reconciler) ReconcileDeployments( //nolint: gocyclo,gocognit,funlen
 
 	r.Log.Info("creating missing deployments")
 
-	renderedMissingDeployments := r.DeploymentReconciler.RenderAll(
+	// nodes restored from a topology snapshot get the snapshot artifacts mounted in addition to
+	// the "normal" files from configmaps
+	renderTopology := topologyWithRestoreFiles(
 		owningTopology,
+		reconcileData.RestoreFilesFromConfigMap,
+	)
+
+	renderedMissingDeployments := r.DeploymentReconciler.RenderAll(
+		renderTopology,
 		reconcileData.ResolvedConfigs,
 		deployments.Missing,
 	)
@@ -735,7 +1288,7 @@ func (r *Reconciler) ReconcileDeployments( //nolint: gocyclo,gocognit,funlen
 
 	for existingCurrentDeploymentNodeName, existingCurrentDeployment := range deployments.Current {
 		renderedCurrentDeployment := r.DeploymentReconciler.Render(
-			owningTopology,
+			renderTopology,
 			reconcileData.ResolvedConfigs,
 			existingCurrentDeploymentNodeName,
 		)
@@ -827,6 +1380,12 @@ func (r *Reconciler) ReconcileDeployments( //nolint: gocyclo,gocognit,funlen
 
 	r.resolveTopologyState(owningTopology, reconcileData)
 
+	r.recordTopologyStateChange(owningTopology, reconcileData)
+
+	r.recordNodeStatusChanges(owningTopology, reconcileData, deployments)
+
+	r.recordTopologyDeployDuration(owningTopology, reconcileData)
+
 	if !reflect.DeepEqual(reconcileData.NodeStatuses, reconcileData.PreviousNodeStatuses) {
 		reconcileData.ShouldUpdateResource = true
 	}
@@ -842,6 +1401,10 @@ func (r *Reconciler) ReconcileDeployments( //nolint: gocyclo,gocognit,funlen
 		reconcileData.ShouldUpdateResource = true
 	}
 
+	if !maps.Equal(reconcileData.NodeRestarts, owningTopology.Status.NodeRestarts) {
+		reconcileData.ShouldUpdateResource = true
+	}
+
 	return r.reconcileDeploymentsHandleRestarts(
 		ctx,
 		owningTopology,
@@ -912,16 +1475,26 @@ func (r *Reconciler) collectNodeProbeStatuses(
 			probeStatuses.ReadinessProbe = clabernetesapisv1alpha1.NodeProbeStatusDisabled
 		}
 
-		if container.LivenessProbe != nil {
+		livenessAnnotation := clabernetesconstants.AnnotationNodeLiveness
+
+		nodeLiveness, launcherHandlesLiveness := pod.Annotations[livenessAnnotation]
+
+		switch {
+		case container.LivenessProbe != nil:
 			// liveness probe - check if pod is running (not being restarted)
 			if pod.Status.Phase == k8scorev1.PodRunning {
 				probeStatuses.LivenessProbe = clabernetesapisv1alpha1.NodeProbeStatusPassing
 			} else {
 				probeStatuses.LivenessProbe = clabernetesapisv1alpha1.NodeProbeStatusFailing
 			}
+		case launcherHandlesLiveness:
+			// liveness restart policy is handled by the launcher, which reports the status itself
+			probeStatuses.LivenessProbe = clabernetesapisv1alpha1.NodeProbeStatus(nodeLiveness)
 		}
 
 		reconcileData.NodeProbeStatuses[nodeName] = probeStatuses
+
+		reconcileData.NodeRestarts[nodeName] = nodeRestartsFromPod(&pod)
 	}
 
 	for _, missingName := range deployments.Missing {
@@ -933,6 +1506,26 @@ func (r *Reconciler) collectNodeProbeStatuses(
 	}
 }
 
+// nodeRestartsFromPod returns the number of times the node of the given launcher pod has been
+// restarted -- that is the restarts of the launcher container itself plus the restarts of the node
+// container the launcher reports via annotation.
+func nodeRestartsFromPod(pod *k8scorev1.Pod) int {
+	var restarts int
+
+	if len(pod.Status.ContainerStatuses) > 0 {
+		restarts = int(pod.Status.ContainerStatuses[0].RestartCount)
+	}
+
+	launcherRestartsAnnotation := pod.Annotations[clabernetesconstants.AnnotationNodeRestarts]
+
+	launcherRestarts, err := strconv.Atoi(launcherRestartsAnnotation)
+	if err == nil {
+		restarts += launcherRestarts
+	}
+
+	return restarts
+}
+
 func probeStatusFromPodCondition(
 	containerStatuses []k8scorev1.ContainerStatus,
 	isStartup bool,
@@ -969,6 +1562,50 @@ func probeStatusFromPodCondition(
 	return clabernetesapisv1alpha1.NodeProbeStatusUnknown
 }
 
+// recordTopologyDeployDuration tracks when the topology entered the "deploying" state and records
+// the time spent deploying once it transitions to "running". The start time is kept through any
+// states in between (degraded, deployfailed) until the topology is running or deleted. If the
+// topology was already deploying before we started tracking it (i.e. the manager restarted mid
+// deploy) nothing is recorded as we can't know how long it has been deploying for.
+func (r *Reconciler) recordTopologyDeployDuration(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+) {
+	previousState := owningTopology.Status.TopologyState
+
+	switch reconcileData.TopologyState { //nolint:exhaustive
+	case clabernetesapisv1alpha1.TopologyStateDeploying:
+		deployingSince := time.Now()
+		if previousState == "" {
+			// first time we resolve a state, so the topology has been deploying since creation
+			deployingSince = owningTopology.CreationTimestamp.Time
+		}
+
+		if previousState != clabernetesapisv1alpha1.TopologyStateDeploying {
+			// keep the original start time if the deploy already started before
+			r.deployingSince.LoadOrStore(owningTopology.UID, deployingSince)
+		}
+	case clabernetesapisv1alpha1.TopologyStateRunning:
+		storedDeployingSince, _ := r.deployingSince.LoadAndDelete(owningTopology.UID)
+
+		deployingSince, ok := storedDeployingSince.(time.Time)
+		if ok {
+			clabernetesmetrics.RecordTopologyDeployed(time.Since(deployingSince))
+		} else if previousState == "" {
+			// went straight to running on the first reconcile
+			clabernetesmetrics.RecordTopologyDeployed(
+				time.Since(owningTopology.CreationTimestamp.Time),
+			)
+		}
+	}
+}
+
+// ForgetTopology drops any state the Reconciler tracks in memory for the given topology, this
+// should be called once the topology is deleted.
+func (r *Reconciler) ForgetTopology(uid apimachinerytypes.UID) {
+	r.deployingSince.Delete(uid)
+}
+
 func (r *Reconciler) resolveTopologyState(
 	owningTopology *clabernetesapisv1alpha1.Topology,
 	reconcileData *ReconcileData,
@@ -1092,6 +1729,9 @@ func (r *Reconciler) reconcileDeploymentsHandleRestarts(
 		nodeDeployment.Spec.Template.ObjectMeta.Annotations["kubectl.kubernetes.io/restartedAt"] = now //nolint:lll
 
 		err = r.updateObj(ctx, nodeDeployment, clabernetesconstants.KubernetesDeployment)
+
+		r.recordNodeRestart(owningTopology, nodeDeployment, nodeName, err)
+
 		if err != nil {
 			r.Log.Warnf("failed restarting deployment for node %q, err: %s", nodeName, err)
 
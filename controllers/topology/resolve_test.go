@@ -25,6 +25,7 @@ import (
 	k8scorev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoevents "k8s.io/client-go/tools/events"
 	ctrlruntimeclientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -135,6 +136,7 @@ func TestReconcileResolveDeployment(t *testing.T) {
 				r := clabernetescontrollerstopology.NewReconciler(
 					&claberneteslogging.FakeInstance{},
 					fakeClient,
+					&clientgoevents.FakeRecorder{},
 					"clabernetes",
 					"clabernetes",
 					"containerd",
@@ -294,6 +296,7 @@ func TestReconcileResolvePVC(t *testing.T) {
 				r := clabernetescontrollerstopology.NewReconciler(
 					&claberneteslogging.FakeInstance{},
 					fakeClient,
+					&clientgoevents.FakeRecorder{},
 					"clabernetes",
 					"clabernetes",
 					"containerd",
@@ -458,6 +461,7 @@ func TestReconcileResolveServiceFabric(t *testing.T) {
 				r := clabernetescontrollerstopology.NewReconciler(
 					&claberneteslogging.FakeInstance{},
 					fakeClient,
+					&clientgoevents.FakeRecorder{},
 					"clabernetes",
 					"clabernetes",
 					"containerd",
@@ -622,6 +626,7 @@ func TestReconcileResolveServiceExpose(t *testing.T) {
 				r := clabernetescontrollerstopology.NewReconciler(
 					&claberneteslogging.FakeInstance{},
 					fakeClient,
+					&clientgoevents.FakeRecorder{},
 					"clabernetes",
 					"clabernetes",
 					"containerd",
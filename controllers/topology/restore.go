@@ -0,0 +1,229 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// restoreStartupConfigDir is the directory (relative to the clabernetes working directory in
+	// the launcher) that snapshot artifacts are mounted into when restoring a topology.
+	restoreStartupConfigDir = "snapshots"
+)
+
+// snapshotStartupConfigArtifactPaths maps containerlab kinds to the path (relative to the node
+// directory) of the artifact written by "containerlab save" that can be used as the startup-config
+// of a node of that kind.
+var snapshotStartupConfigArtifactPaths = map[string]string{ //nolint:gochecknoglobals
+	"srl":           "config/config.json",
+	"nokia_srlinux": "config/config.json",
+	"ceos":          "flash/startup-config",
+	"arista_ceos":   "flash/startup-config",
+	"crpd":          "config/juniper.conf",
+	"juniper_crpd":  "config/juniper.conf",
+	"vr-sros":       "tftpboot/config.txt",
+	"vr-nokia_sros": "tftpboot/config.txt",
+	"nokia_sros":    "tftpboot/config.txt",
+}
+
+// SnapshotStartupConfigArtifact returns the artifact of the given TopologySnapshot node status
+// that should be used as the startup-config of the node when restoring it. If the kind of the node
+// is not known, the artifact is only returned if it is the only artifact captured for the node.
+// If no suitable artifact was captured, nil is returned.
+func SnapshotStartupConfigArtifact(
+	nodeStatus clabernetesapisv1alpha1.TopologySnapshotNodeStatus,
+) *clabernetesapisv1alpha1.TopologySnapshotArtifact {
+	artifactPath, ok := snapshotStartupConfigArtifactPaths[nodeStatus.Kind]
+	if ok {
+		for idx := range nodeStatus.Artifacts {
+			if nodeStatus.Artifacts[idx].Path == artifactPath {
+				return &nodeStatus.Artifacts[idx]
+			}
+		}
+
+		return nil
+	}
+
+	if len(nodeStatus.Artifacts) == 1 {
+		return &nodeStatus.Artifacts[0]
+	}
+
+	return nil
+}
+
+// ResolveSnapshotRestore rewrites the startup-config of each node in the given (resolved)
+// containerlab configs that was captured successfully in the given TopologySnapshot to point to
+// the captured configuration artifact -- this includes the nodes of a node group that run in the
+// launcher of the group primary. The startup-config is enforced so that the restored
+// configuration is applied even when the lab directory of the node is persisted. The files that
+// must be mounted from the snapshot configmaps into each launcher are returned.
+func ResolveSnapshotRestore(
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+	clabernetesConfigs map[string]*clabernetesutilcontainerlab.Config,
+) map[string][]clabernetesapisv1alpha1.FileFromConfigMap {
+	restoreFiles := make(map[string][]clabernetesapisv1alpha1.FileFromConfigMap)
+
+	for launcherName, launcherConfig := range clabernetesConfigs {
+		if launcherConfig == nil || launcherConfig.Topology == nil {
+			continue
+		}
+
+		for _, nodeName := range slices.Sorted(maps.Keys(launcherConfig.Topology.Nodes)) {
+			nodeDefinition := launcherConfig.Topology.Nodes[nodeName]
+			if nodeDefinition == nil {
+				continue
+			}
+
+			nodeStatus, ok := topologySnapshot.Status.Nodes[nodeName]
+			if !ok ||
+				nodeStatus.Phase != clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded {
+				continue
+			}
+
+			artifact := SnapshotStartupConfigArtifact(nodeStatus)
+			if artifact == nil {
+				continue
+			}
+
+			// the snapshot name is part of the path so that switching between snapshots changes
+			// the node config (and therefore restarts the node) even if the artifact paths are
+			// the same, the node name keeps the files of the nodes of a node group apart
+			filePath := path.Join(
+				restoreStartupConfigDir,
+				topologySnapshot.Name,
+				nodeName,
+				artifact.Path,
+			)
+
+			nodeDefinition.StartupConfig = filePath
+			nodeDefinition.EnforceStartupConfig = true
+
+			restoreFiles[launcherName] = append(
+				restoreFiles[launcherName],
+				clabernetesapisv1alpha1.FileFromConfigMap{
+					FilePath:      filePath,
+					ConfigMapName: nodeStatus.ConfigMapName,
+					ConfigMapPath: artifact.Key,
+					Mode:          clabernetesconstants.FileModeRead,
+				},
+			)
+		}
+	}
+
+	return restoreFiles
+}
+
+// ReconcileSnapshotRestore restores the node configurations of the topology from the
+// TopologySnapshot set in the topology deployment spec (if any). The resolved configs in the
+// reconcile data are updated to use the snapshot artifacts as startup-configs, which in turn
+// causes the affected nodes to be restarted when the deployments are reconciled.
+func (r *Reconciler) ReconcileSnapshotRestore(
+	ctx context.Context,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+) error {
+	topologySnapshotName := owningTopology.Spec.Deployment.RestoreFromSnapshot
+	if topologySnapshotName == "" {
+		return nil
+	}
+
+	topologySnapshot := &clabernetesapisv1alpha1.TopologySnapshot{}
+
+	err := r.Client.Get(
+		ctx,
+		apimachinerytypes.NamespacedName{
+			Namespace: owningTopology.GetNamespace(),
+			Name:      topologySnapshotName,
+		},
+		topologySnapshot,
+	)
+	if err != nil {
+		if apimachineryerrors.IsNotFound(err) {
+			return fmt.Errorf(
+				"%w: topology snapshot %q does not exist",
+				claberneteserrors.ErrReconcile,
+				topologySnapshotName,
+			)
+		}
+
+		return err
+	}
+
+	if topologySnapshot.Status.Phase != clabernetesapisv1alpha1.TopologySnapshotPhaseCompleted {
+		return fmt.Errorf(
+			"%w: topology snapshot %q is not completed, current phase %q",
+			claberneteserrors.ErrReconcile,
+			topologySnapshotName,
+			topologySnapshot.Status.Phase,
+		)
+	}
+
+	reconcileData.RestoreFilesFromConfigMap = ResolveSnapshotRestore(
+		topologySnapshot,
+		reconcileData.ResolvedConfigs,
+	)
+
+	for _, launcherConfig := range reconcileData.ResolvedConfigs {
+		if launcherConfig == nil || launcherConfig.Topology == nil {
+			continue
+		}
+
+		for nodeName := range launcherConfig.Topology.Nodes {
+			nodeStatus, ok := topologySnapshot.Status.Nodes[nodeName]
+			if ok &&
+				nodeStatus.Phase == clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded &&
+				SnapshotStartupConfigArtifact(nodeStatus) != nil {
+				continue
+			}
+
+			r.Log.Warnf(
+				"node %q has no usable configuration in topology snapshot %q, not restoring node",
+				nodeName,
+				topologySnapshotName,
+			)
+		}
+	}
+
+	return nil
+}
+
+// topologyWithRestoreFiles returns the topology that launcher deployments should be rendered for.
+// If nodes are being restored from a snapshot, this is a copy of the owning topology with the
+// snapshot files appended to the FilesFromConfigMap of the restored nodes, otherwise it is the
+// owning topology itself. The copy is never written back to the cluster, so the user provided
+// spec is left untouched.
+func topologyWithRestoreFiles(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	restoreFiles map[string][]clabernetesapisv1alpha1.FileFromConfigMap,
+) *clabernetesapisv1alpha1.Topology {
+	if len(restoreFiles) == 0 {
+		return owningTopology
+	}
+
+	renderTopology := owningTopology.DeepCopy()
+
+	if renderTopology.Spec.Deployment.FilesFromConfigMap == nil {
+		renderTopology.Spec.Deployment.FilesFromConfigMap = make(
+			map[string][]clabernetesapisv1alpha1.FileFromConfigMap,
+		)
+	}
+
+	for nodeName, nodeRestoreFiles := range restoreFiles {
+		renderTopology.Spec.Deployment.FilesFromConfigMap[nodeName] = slices.Concat(
+			renderTopology.Spec.Deployment.FilesFromConfigMap[nodeName],
+			nodeRestoreFiles,
+		)
+	}
+
+	return renderTopology
+}
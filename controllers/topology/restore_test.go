@@ -0,0 +1,256 @@
+package topology_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	clabernetestesthelper "github.com/srl-labs/clabernetes/testhelper"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const resolveSnapshotRestoreTestName = "restore/resolve-snapshot-restore"
+
+type resolveSnapshotRestoreResult struct {
+	Configs map[string]*clabernetesutilcontainerlab.Config         `json:"configs"`
+	Files   map[string][]clabernetesapisv1alpha1.FileFromConfigMap `json:"files"`
+}
+
+func nodeConfig(nodeName, kind string) *clabernetesutilcontainerlab.Config {
+	return &clabernetesutilcontainerlab.Config{
+		Name: fmt.Sprintf("clabernetes-%s", nodeName),
+		Topology: &clabernetesutilcontainerlab.Topology{
+			Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+				nodeName: {
+					Kind:          kind,
+					StartupConfig: "original.cfg",
+				},
+			},
+		},
+	}
+}
+
+// TestResolveSnapshotRestore ensures that the startup-config of the nodes captured in a
+// TopologySnapshot are rewritten to the snapshot artifacts, and that the matching files to mount
+// from the snapshot configmaps are returned.
+func TestResolveSnapshotRestore(t *testing.T) {
+	cases := []struct {
+		name               string
+		topologySnapshot   *clabernetesapisv1alpha1.TopologySnapshot
+		clabernetesConfigs map[string]*clabernetesutilcontainerlab.Config
+	}{
+		{
+			name: "known-kinds",
+			topologySnapshot: &clabernetesapisv1alpha1.TopologySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "known-kinds",
+					Namespace: "clabernetes",
+				},
+				Status: clabernetesapisv1alpha1.TopologySnapshotStatus{
+					Phase: clabernetesapisv1alpha1.TopologySnapshotPhaseCompleted,
+					Nodes: map[string]clabernetesapisv1alpha1.TopologySnapshotNodeStatus{
+						"srl1": {
+							Phase:         clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded,
+							Kind:          "nokia_srlinux",
+							ConfigMapName: "known-kinds-srl1",
+							Artifacts: []clabernetesapisv1alpha1.TopologySnapshotArtifact{
+								{
+									Path: "config/checkpoint/checkpoint-0.json",
+									Key:  "config__checkpoint__checkpoint-0.json",
+								},
+								{
+									Path: "config/config.json",
+									Key:  "config__config.json",
+								},
+							},
+						},
+						"ceos1": {
+							Phase:         clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded,
+							Kind:          "ceos",
+							ConfigMapName: "known-kinds-ceos1",
+							Artifacts: []clabernetesapisv1alpha1.TopologySnapshotArtifact{
+								{
+									Path: "flash/startup-config",
+									Key:  "flash__startup-config",
+								},
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1":  nodeConfig("srl1", "nokia_srlinux"),
+				"ceos1": nodeConfig("ceos1", "ceos"),
+			},
+		},
+		{
+			name: "partial",
+			topologySnapshot: &clabernetesapisv1alpha1.TopologySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "partial",
+					Namespace: "clabernetes",
+				},
+				Status: clabernetesapisv1alpha1.TopologySnapshotStatus{
+					Phase: clabernetesapisv1alpha1.TopologySnapshotPhaseCompleted,
+					Nodes: map[string]clabernetesapisv1alpha1.TopologySnapshotNodeStatus{
+						"failed1": {
+							Phase:   clabernetesapisv1alpha1.TopologySnapshotNodePhaseFailed,
+							Kind:    "nokia_srlinux",
+							Message: "exec failed",
+						},
+						"missing-artifact1": {
+							Phase:         clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded,
+							Kind:          "nokia_srlinux",
+							ConfigMapName: "partial-missing-artifact1",
+							Artifacts: []clabernetesapisv1alpha1.TopologySnapshotArtifact{
+								{
+									Path: "config/tls/server.key",
+									Key:  "config__tls__server.key",
+								},
+							},
+						},
+						"linux1": {
+							Phase:         clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded,
+							Kind:          "linux",
+							ConfigMapName: "partial-linux1",
+							Artifacts: []clabernetesapisv1alpha1.TopologySnapshotArtifact{
+								{
+									Path: "frr.conf",
+									Key:  "frr.conf",
+								},
+							},
+						},
+						"linux2": {
+							Phase:         clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded,
+							Kind:          "linux",
+							ConfigMapName: "partial-linux2",
+							Artifacts: []clabernetesapisv1alpha1.TopologySnapshotArtifact{
+								{
+									Path: "daemons",
+									Key:  "daemons",
+								},
+								{
+									Path: "frr.conf",
+									Key:  "frr.conf",
+								},
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"failed1":           nodeConfig("failed1", "nokia_srlinux"),
+				"missing-artifact1": nodeConfig("missing-artifact1", "nokia_srlinux"),
+				"linux1":            nodeConfig("linux1", "linux"),
+				"linux2":            nodeConfig("linux2", "linux"),
+				"not-captured1":     nodeConfig("not-captured1", "linux"),
+			},
+		},
+		{
+			name: "node-group",
+			topologySnapshot: &clabernetesapisv1alpha1.TopologySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "node-group",
+					Namespace: "clabernetes",
+				},
+				Status: clabernetesapisv1alpha1.TopologySnapshotStatus{
+					Phase: clabernetesapisv1alpha1.TopologySnapshotPhaseCompleted,
+					Nodes: map[string]clabernetesapisv1alpha1.TopologySnapshotNodeStatus{
+						"srl1": {
+							Phase:         clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded,
+							Kind:          "nokia_srlinux",
+							ConfigMapName: "node-group-srl1",
+							Artifacts: []clabernetesapisv1alpha1.TopologySnapshotArtifact{
+								{
+									Path: "config/config.json",
+									Key:  "config__config.json",
+								},
+							},
+						},
+						"srl2": {
+							Phase:         clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded,
+							Kind:          "nokia_srlinux",
+							ConfigMapName: "node-group-srl2",
+							Artifacts: []clabernetesapisv1alpha1.TopologySnapshotArtifact{
+								{
+									Path: "config/config.json",
+									Key:  "config__config.json",
+								},
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": {
+					Name: "clabernetes-srl1",
+					Topology: &clabernetesutilcontainerlab.Topology{
+						Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+							"srl1": {
+								Kind:          "nokia_srlinux",
+								StartupConfig: "original.cfg",
+							},
+							"srl2": {
+								Kind:          "nokia_srlinux",
+								StartupConfig: "original.cfg",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				files := clabernetescontrollerstopology.ResolveSnapshotRestore(
+					testCase.topologySnapshot,
+					testCase.clabernetesConfigs,
+				)
+
+				got := resolveSnapshotRestoreResult{
+					Configs: testCase.clabernetesConfigs,
+					Files:   files,
+				}
+
+				if *clabernetestesthelper.Update {
+					clabernetestesthelper.WriteTestFixtureJSON(
+						t,
+						fmt.Sprintf(
+							"golden/%s/%s.json",
+							resolveSnapshotRestoreTestName,
+							testCase.name,
+						),
+						got,
+					)
+				}
+
+				var want resolveSnapshotRestoreResult
+
+				err := json.Unmarshal(
+					clabernetestesthelper.ReadTestFixtureFile(
+						t,
+						fmt.Sprintf(
+							"golden/%s/%s.json",
+							resolveSnapshotRestoreTestName,
+							testCase.name,
+						),
+					),
+					&want,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				clabernetestesthelper.MarshaledEqual(t, got, want)
+			},
+		)
+	}
+}
@@ -21,13 +21,16 @@ import (
 )
 
 const (
-	exposeTypeNone     = "None"
-	exposeTypeHeadless = "Headless"
+	exposeTypeNone               = "None"
+	exposeTypeHeadless           = "Headless"
+	exposeTypeSharedLoadBalancer = "SharedLoadBalancer"
 )
 
 func exposeTypeToServiceType(exposeType string) k8scorev1.ServiceType {
 	switch exposeType {
-	case string(k8scorev1.ServiceTypeClusterIP), exposeTypeHeadless:
+	case string(k8scorev1.ServiceTypeClusterIP), exposeTypeHeadless, exposeTypeSharedLoadBalancer:
+		// with a shared load balancer the nodes are exposed via the one shared service, so the
+		// per node services are just clusterip services
 		return k8scorev1.ServiceTypeClusterIP
 	default:
 		return k8scorev1.ServiceTypeLoadBalancer
@@ -94,6 +97,20 @@ func (r *ServiceExposeReconciler) Resolve(
 		services.Current[nodeName] = &ownedServices.Items[i]
 	}
 
+	exposedNodes := r.exposedNodes(clabernetesConfigs, owningTopology)
+
+	services.SetMissing(exposedNodes)
+	services.SetExtra(exposedNodes)
+
+	return services, nil
+}
+
+// exposedNodes returns the names of the nodes of the given clabernetes configs that get exposed
+// via a service.
+func (r *ServiceExposeReconciler) exposedNodes(
+	clabernetesConfigs map[string]*clabernetesutilcontainerlab.Config,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+) []string {
 	exposedNodes := make([]string, 0)
 
 	disableExpose := owningTopology.Spec.Expose.DisableExpose
@@ -106,6 +123,12 @@ func (r *ServiceExposeReconciler) Resolve(
 			continue
 		}
 
+		// segment (bridge) nodes have nothing to expose
+		if nodeData != nil && nodeData.Topology != nil &&
+			nodeData.Topology.IsSegmentNode(nodeName) {
+			continue
+		}
+
 		// if disable auto expose is true *and* there are no ports defined for the node *and*
 		// there are no default ports defined for the topology we can skip the node from an expose
 		// perspective.
@@ -124,10 +147,7 @@ func (r *ServiceExposeReconciler) Resolve(
 		exposedNodes = append(exposedNodes, nodeName)
 	}
 
-	services.SetMissing(exposedNodes)
-	services.SetExtra(exposedNodes)
-
-	return services, nil
+	return exposedNodes
 }
 
 // Render accepts the owning topology a mapping of clabernetes sub-topology configs and a node name
@@ -281,11 +301,18 @@ func (r *ServiceExposeReconciler) renderServicePorts(
 	service *k8scorev1.Service,
 	nodeName string,
 ) {
-	reconcileData.ResolvedExposedPorts[nodeName] = &clabernetesapisv1alpha1.ExposedPorts{
-		TCPPorts: make([]int, 0),
-		UDPPorts: make([]int, 0),
-	}
+	ports := r.nodeServicePorts(reconcileData, nodeName)
 
+	r.resolveExposedPorts(reconcileData, nodeName, ports)
+
+	service.Spec.Ports = ports
+}
+
+// nodeServicePorts returns the (sorted) service ports for the ports to expose on the given node.
+func (r *ServiceExposeReconciler) nodeServicePorts(
+	reconcileData *ReconcileData,
+	nodeName string,
+) []k8scorev1.ServicePort {
 	ports := make([]k8scorev1.ServicePort, 0)
 
 	// for actual containerlab configs we copy the users given defaults into each "sub topology" --
@@ -312,8 +339,23 @@ func (r *ServiceExposeReconciler) renderServicePorts(
 		}
 
 		ports = append(ports, *port)
+	}
 
-		// dont forget to update the exposed ports status bits
+	return ports
+}
+
+// resolveExposedPorts sets the exposed ports status bits for the given node and its ports.
+func (r *ServiceExposeReconciler) resolveExposedPorts(
+	reconcileData *ReconcileData,
+	nodeName string,
+	ports []k8scorev1.ServicePort,
+) {
+	reconcileData.ResolvedExposedPorts[nodeName] = &clabernetesapisv1alpha1.ExposedPorts{
+		TCPPorts: make([]int, 0),
+		UDPPorts: make([]int, 0),
+	}
+
+	for _, port := range ports {
 		if port.Protocol == clabernetesconstants.TCP {
 			reconcileData.ResolvedExposedPorts[nodeName].TCPPorts = append(
 				reconcileData.ResolvedExposedPorts[nodeName].TCPPorts,
@@ -326,8 +368,6 @@ func (r *ServiceExposeReconciler) renderServicePorts(
 			)
 		}
 	}
-
-	service.Spec.Ports = ports
 }
 
 func (r *ServiceExposeReconciler) processMgmtLoadbalanacerExpose(
@@ -0,0 +1,316 @@
+package topology
+
+import (
+	"fmt"
+	"maps"
+	"net"
+	"reflect"
+	"slices"
+	"strings"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+	clabernetesutilkubernetes "github.com/srl-labs/clabernetes/util/kubernetes"
+	k8scorev1 "k8s.io/api/core/v1"
+	k8sdiscoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// sharedExposePortBase is the first port handed out on the shared load balancer, node ports
+	// get mapped to the lowest free port from here on up.
+	sharedExposePortBase = 10000
+	sharedExposePortMax  = 65535
+)
+
+// SharedExposeServiceName returns the name of the shared load balancer service exposing the nodes
+// of the given topology when using the "SharedLoadBalancer" expose type.
+func SharedExposeServiceName(owningTopology *clabernetesapisv1alpha1.Topology) string {
+	return fmt.Sprintf("%s-expose", owningTopology.GetName())
+}
+
+func sharedExposePortKey(port k8scorev1.ServicePort) string {
+	return fmt.Sprintf("%d/%s", port.Port, strings.ToLower(string(port.Protocol)))
+}
+
+func sharedExposeEndpointSliceName(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	nodeName string,
+) string {
+	return clabernetesutilkubernetes.SafeConcatNameKubernetes(
+		SharedExposeServiceName(owningTopology),
+		nodeName,
+	)
+}
+
+// RenderShared accepts the owning topology and the reconcile data and renders the single load
+// balancer service exposing the ports of all exposed nodes of the topology. Every node port is
+// mapped to a distinct port on the load balancer, ports that were mapped before (as per the
+// topology status) keep their mapping so nodes don't move around on the load balancer as the
+// topology changes. The mapping is recorded in the resolved exposed ports of the reconcile data.
+func (r *ServiceExposeReconciler) RenderShared(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+) *k8scorev1.Service {
+	nodeNames := r.exposedNodes(reconcileData.ResolvedConfigs, owningTopology)
+	slices.Sort(nodeNames)
+
+	nodePorts := make(map[string][]k8scorev1.ServicePort, len(nodeNames))
+	externalPorts := make(map[string]map[string]int, len(nodeNames))
+	usedExternalPorts := make(map[int]bool)
+
+	for _, nodeName := range nodeNames {
+		nodePorts[nodeName] = r.nodeServicePorts(reconcileData, nodeName)
+		externalPorts[nodeName] = make(map[string]int)
+
+		previousExposedPorts := owningTopology.Status.ExposedPorts[nodeName]
+		if previousExposedPorts == nil {
+			continue
+		}
+
+		for _, port := range nodePorts[nodeName] {
+			key := sharedExposePortKey(port)
+
+			externalPort, ok := previousExposedPorts.ExternalPorts[key]
+			if !ok || usedExternalPorts[externalPort] ||
+				externalPort < sharedExposePortBase || externalPort > sharedExposePortMax {
+				continue
+			}
+
+			usedExternalPorts[externalPort] = true
+			externalPorts[nodeName][key] = externalPort
+		}
+	}
+
+	servicePorts := make([]k8scorev1.ServicePort, 0)
+
+	nextExternalPort := sharedExposePortBase
+
+	for _, nodeName := range nodeNames {
+		for _, port := range nodePorts[nodeName] {
+			key := sharedExposePortKey(port)
+
+			externalPort, ok := externalPorts[nodeName][key]
+			if !ok {
+				for usedExternalPorts[nextExternalPort] {
+					nextExternalPort++
+				}
+
+				if nextExternalPort > sharedExposePortMax {
+					r.log.Warnf(
+						"no free port left on shared load balancer, skipping port %s of node %q",
+						key,
+						nodeName,
+					)
+
+					continue
+				}
+
+				externalPort = nextExternalPort
+				usedExternalPorts[externalPort] = true
+				externalPorts[nodeName][key] = externalPort
+			}
+
+			servicePorts = append(servicePorts, k8scorev1.ServicePort{
+				Name: fmt.Sprintf(
+					"port-%d-%s", externalPort, strings.ToLower(string(port.Protocol)),
+				),
+				Protocol:   port.Protocol,
+				Port:       int32(externalPort), //nolint: gosec
+				TargetPort: port.TargetPort,
+			})
+		}
+
+		if reconcileData.ResolvedExposedPorts[nodeName] == nil {
+			r.resolveExposedPorts(reconcileData, nodeName, nodePorts[nodeName])
+		}
+
+		reconcileData.ResolvedExposedPorts[nodeName].ExternalPorts = externalPorts[nodeName]
+	}
+
+	slices.SortFunc(servicePorts, func(a, b k8scorev1.ServicePort) int {
+		return int(a.Port - b.Port)
+	})
+
+	annotations, globalLabels := r.configManagerGetter().GetAllMetadata()
+
+	serviceName := SharedExposeServiceName(owningTopology)
+	serviceType := clabernetesconstants.TopologyServiceTypeSharedExpose
+
+	labels := map[string]string{
+		clabernetesconstants.LabelApp:                 clabernetesconstants.Clabernetes,
+		clabernetesconstants.LabelName:                serviceName,
+		clabernetesconstants.LabelTopologyOwner:       owningTopology.GetName(),
+		clabernetesconstants.LabelTopologyKind:        GetTopologyKind(owningTopology),
+		clabernetesconstants.LabelTopologyServiceType: serviceType,
+	}
+
+	maps.Copy(labels, globalLabels)
+
+	return &k8scorev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        serviceName,
+			Namespace:   owningTopology.GetNamespace(),
+			Annotations: annotations,
+			Labels:      labels,
+		},
+		Spec: k8scorev1.ServiceSpec{
+			// no selector -- every port goes to a different launcher pod, so we manage the
+			// endpoint slices backing this service ourselves
+			Type:  k8scorev1.ServiceTypeLoadBalancer,
+			Ports: servicePorts,
+		},
+	}
+}
+
+// RenderSharedEndpointSlices accepts the owning topology, the reconcile data (as populated by
+// RenderShared), the rendered shared service and a mapping of node name to launcher pod and renders
+// an endpoint slice per exposed node. Each endpoint slice points the shared service ports of its
+// node at the launcher pod of that node, kubernetes matches the service ports to the endpoint
+// slice ports by name. Nodes whose launcher pod has no address yet get an endpoint slice without
+// any endpoints.
+func (r *ServiceExposeReconciler) RenderSharedEndpointSlices(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	reconcileData *ReconcileData,
+	sharedService *k8scorev1.Service,
+	launcherPods map[string]*k8scorev1.Pod,
+) []*k8sdiscoveryv1.EndpointSlice {
+	annotations, globalLabels := r.configManagerGetter().GetAllMetadata()
+
+	serviceType := clabernetesconstants.TopologyServiceTypeSharedExpose
+
+	endpointSlices := make([]*k8sdiscoveryv1.EndpointSlice, 0)
+
+	for _, nodeName := range slices.Sorted(maps.Keys(reconcileData.ResolvedExposedPorts)) {
+		nodeExternalPorts := reconcileData.ResolvedExposedPorts[nodeName].ExternalPorts
+		if len(nodeExternalPorts) == 0 {
+			continue
+		}
+
+		nodeServicePorts := make(map[int]bool, len(nodeExternalPorts))
+
+		for _, externalPort := range nodeExternalPorts {
+			nodeServicePorts[externalPort] = true
+		}
+
+		ports := make([]k8sdiscoveryv1.EndpointPort, 0)
+
+		for _, servicePort := range sharedService.Spec.Ports {
+			if !nodeServicePorts[int(servicePort.Port)] {
+				continue
+			}
+
+			ports = append(ports, k8sdiscoveryv1.EndpointPort{
+				Name:     clabernetesutil.ToPointer(servicePort.Name),
+				Protocol: clabernetesutil.ToPointer(servicePort.Protocol),
+				Port:     clabernetesutil.ToPointer(servicePort.TargetPort.IntVal),
+			})
+		}
+
+		addressType := k8sdiscoveryv1.AddressTypeIPv4
+		endpoints := make([]k8sdiscoveryv1.Endpoint, 0)
+
+		launcherPod, ok := launcherPods[nodeName]
+		if ok && launcherPod.Status.PodIP != "" {
+			if net.ParseIP(launcherPod.Status.PodIP).To4() == nil {
+				addressType = k8sdiscoveryv1.AddressTypeIPv6
+			}
+
+			endpoints = append(endpoints, k8sdiscoveryv1.Endpoint{
+				Addresses: []string{launcherPod.Status.PodIP},
+				Conditions: k8sdiscoveryv1.EndpointConditions{
+					Ready: clabernetesutil.ToPointer(launcherPodReady(launcherPod)),
+				},
+				TargetRef: &k8scorev1.ObjectReference{
+					Kind:      "Pod",
+					Namespace: launcherPod.GetNamespace(),
+					Name:      launcherPod.GetName(),
+					UID:       launcherPod.GetUID(),
+				},
+			})
+		}
+
+		labels := map[string]string{
+			k8sdiscoveryv1.LabelServiceName:               sharedService.GetName(),
+			k8sdiscoveryv1.LabelManagedBy:                 clabernetesconstants.Clabernetes,
+			clabernetesconstants.LabelApp:                 clabernetesconstants.Clabernetes,
+			clabernetesconstants.LabelTopologyOwner:       owningTopology.GetName(),
+			clabernetesconstants.LabelTopologyNode:        nodeName,
+			clabernetesconstants.LabelTopologyServiceType: serviceType,
+		}
+
+		maps.Copy(labels, globalLabels)
+
+		endpointSlices = append(endpointSlices, &k8sdiscoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        sharedExposeEndpointSliceName(owningTopology, nodeName),
+				Namespace:   owningTopology.GetNamespace(),
+				Annotations: annotations,
+				Labels:      labels,
+			},
+			AddressType: addressType,
+			Endpoints:   endpoints,
+			Ports:       ports,
+		})
+	}
+
+	return endpointSlices
+}
+
+func launcherPodReady(pod *k8scorev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == k8scorev1.PodReady {
+			return condition.Status == k8scorev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// EndpointSliceConforms checks if the existingEndpointSlice conforms with the
+// renderedEndpointSlice.
+func (r *ServiceExposeReconciler) EndpointSliceConforms(
+	existingEndpointSlice,
+	renderedEndpointSlice *k8sdiscoveryv1.EndpointSlice,
+	expectedOwnerUID apimachinerytypes.UID,
+) bool {
+	if existingEndpointSlice.AddressType != renderedEndpointSlice.AddressType {
+		return false
+	}
+
+	if !reflect.DeepEqual(existingEndpointSlice.Endpoints, renderedEndpointSlice.Endpoints) {
+		return false
+	}
+
+	if !reflect.DeepEqual(existingEndpointSlice.Ports, renderedEndpointSlice.Ports) {
+		return false
+	}
+
+	if !clabernetesutilkubernetes.ExistingMapStringStringContainsAllExpectedKeyValues(
+		existingEndpointSlice.ObjectMeta.Annotations,
+		renderedEndpointSlice.ObjectMeta.Annotations,
+	) {
+		return false
+	}
+
+	if !clabernetesutilkubernetes.ExistingMapStringStringContainsAllExpectedKeyValues(
+		existingEndpointSlice.ObjectMeta.Labels,
+		renderedEndpointSlice.ObjectMeta.Labels,
+	) {
+		return false
+	}
+
+	if len(existingEndpointSlice.ObjectMeta.OwnerReferences) != 1 {
+		// we should have only one owner reference, the topology
+		return false
+	}
+
+	if existingEndpointSlice.ObjectMeta.OwnerReferences[0].UID != expectedOwnerUID {
+		// owner ref uid is not us
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,241 @@
+package topology_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	clabernetestesthelper "github.com/srl-labs/clabernetes/testhelper"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+	k8scorev1 "k8s.io/api/core/v1"
+	k8sdiscoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const renderServiceExposeSharedTestName = "serviceexpose/render-service-shared"
+
+func sharedExposeTestConfig(nodeName string) *clabernetesutilcontainerlab.Config {
+	return &clabernetesutilcontainerlab.Config{
+		Name:   nodeName,
+		Prefix: clabernetesutil.ToPointer(""),
+		Topology: &clabernetesutilcontainerlab.Topology{
+			Defaults: &clabernetesutilcontainerlab.NodeDefinition{
+				Ports: []string{
+					"60000:21/tcp",
+					"60001:22/tcp",
+					"60002:161/udp",
+				},
+			},
+			Nodes: map[string]*clabernetesutilcontainerlab.NodeDefinition{
+				nodeName: {
+					Kind:  "srl",
+					Image: "ghcr.io/nokia/srlinux",
+				},
+			},
+		},
+	}
+}
+
+func TestRenderServiceExposeShared(t *testing.T) {
+	cases := []struct {
+		name               string
+		owningTopology     *clabernetesapisv1alpha1.Topology
+		clabernetesConfigs map[string]*clabernetesutilcontainerlab.Config
+		launcherPods       map[string]*k8scorev1.Pod
+	}{
+		{
+			name: "simple",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-service-expose-shared-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Expose: clabernetesapisv1alpha1.Expose{
+						ExposeType: "SharedLoadBalancer",
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": sharedExposeTestConfig("srl1"),
+				"srl2": sharedExposeTestConfig("srl2"),
+			},
+			launcherPods: map[string]*k8scorev1.Pod{
+				"srl1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "render-service-expose-shared-test-srl1-abc123",
+						Namespace: "clabernetes",
+					},
+					Status: k8scorev1.PodStatus{
+						PodIP: "10.0.0.1",
+						Conditions: []k8scorev1.PodCondition{
+							{
+								Type:   k8scorev1.PodReady,
+								Status: k8scorev1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "keep-previous-external-ports",
+			owningTopology: &clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "render-service-expose-shared-test",
+					Namespace: "clabernetes",
+				},
+				Spec: clabernetesapisv1alpha1.TopologySpec{
+					Expose: clabernetesapisv1alpha1.Expose{
+						ExposeType: "SharedLoadBalancer",
+					},
+				},
+				Status: clabernetesapisv1alpha1.TopologyStatus{
+					ExposedPorts: map[string]*clabernetesapisv1alpha1.ExposedPorts{
+						"srl2": {
+							TCPPorts: []int{21, 22},
+							UDPPorts: []int{161},
+							ExternalPorts: map[string]int{
+								"21/tcp":  10000,
+								"22/tcp":  10001,
+								"161/udp": 10002,
+							},
+						},
+					},
+				},
+			},
+			clabernetesConfigs: map[string]*clabernetesutilcontainerlab.Config{
+				"srl1": sharedExposeTestConfig("srl1"),
+				"srl2": sharedExposeTestConfig("srl2"),
+			},
+			launcherPods: map[string]*k8scorev1.Pod{},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				reconciler := clabernetescontrollerstopology.NewServiceExposeReconciler(
+					&claberneteslogging.FakeInstance{},
+					clabernetesconfig.GetFakeManager,
+				)
+
+				reconcileData, err := clabernetescontrollerstopology.NewReconcileData(
+					testCase.owningTopology,
+				)
+				if err != nil {
+					t.Fatalf("error creating ReconcileData, err: %s", err)
+				}
+
+				reconcileData.ResolvedConfigs = testCase.clabernetesConfigs
+
+				got := reconciler.RenderShared(testCase.owningTopology, reconcileData)
+
+				gotEndpointSlices := reconciler.RenderSharedEndpointSlices(
+					testCase.owningTopology,
+					reconcileData,
+					got,
+					testCase.launcherPods,
+				)
+
+				if *clabernetestesthelper.Update {
+					clabernetestesthelper.WriteTestFixtureJSON(
+						t,
+						fmt.Sprintf(
+							"golden/%s/%s.json",
+							renderServiceExposeSharedTestName,
+							testCase.name,
+						),
+						got,
+					)
+
+					clabernetestesthelper.WriteTestFixtureJSON(
+						t,
+						fmt.Sprintf(
+							"golden/%s/%s-endpointslices.json",
+							renderServiceExposeSharedTestName,
+							testCase.name,
+						),
+						gotEndpointSlices,
+					)
+
+					clabernetestesthelper.WriteTestFixtureJSON(
+						t,
+						fmt.Sprintf(
+							"golden/%s/%s-status.json",
+							renderServiceExposeSharedTestName,
+							testCase.name,
+						),
+						reconcileData.ResolvedExposedPorts,
+					)
+				}
+
+				var want k8scorev1.Service
+
+				err = json.Unmarshal(
+					clabernetestesthelper.ReadTestFixtureFile(
+						t,
+						fmt.Sprintf(
+							"golden/%s/%s.json",
+							renderServiceExposeSharedTestName,
+							testCase.name,
+						),
+					),
+					&want,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var wantEndpointSlices []*k8sdiscoveryv1.EndpointSlice
+
+				err = json.Unmarshal(
+					clabernetestesthelper.ReadTestFixtureFile(
+						t,
+						fmt.Sprintf(
+							"golden/%s/%s-endpointslices.json",
+							renderServiceExposeSharedTestName,
+							testCase.name,
+						),
+					),
+					&wantEndpointSlices,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var wantExposePortsStatus map[string]*clabernetesapisv1alpha1.ExposedPorts
+
+				err = json.Unmarshal(
+					clabernetestesthelper.ReadTestFixtureFile(
+						t,
+						fmt.Sprintf(
+							"golden/%s/%s-status.json",
+							renderServiceExposeSharedTestName,
+							testCase.name,
+						),
+					),
+					&wantExposePortsStatus,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				clabernetestesthelper.MarshaledEqual(t, got, want)
+				clabernetestesthelper.MarshaledEqual(t, gotEndpointSlices, wantEndpointSlices)
+				clabernetestesthelper.MarshaledEqual(
+					t,
+					reconcileData.ResolvedExposedPorts,
+					wantExposePortsStatus,
+				)
+			})
+	}
+}
@@ -199,6 +199,22 @@ func (r *ServiceFabricReconciler) renderServiceBase(
 						IntVal: clabernetesconstants.SlurpeethServicePort,
 					},
 				},
+				{
+					Name:     "geneve",
+					Protocol: clabernetesconstants.UDP,
+					Port:     clabernetesconstants.GENEVEServicePort,
+					TargetPort: intstr.IntOrString{
+						IntVal: clabernetesconstants.GENEVEServicePort,
+					},
+				},
+				{
+					Name:     "wireguard",
+					Protocol: clabernetesconstants.UDP,
+					Port:     clabernetesconstants.WireGuardServicePort,
+					TargetPort: intstr.IntOrString{
+						IntVal: clabernetesconstants.WireGuardServicePort,
+					},
+				},
 			},
 			Selector: selectorLabels,
 			Type:     k8scorev1.ServiceTypeClusterIP,
@@ -0,0 +1,165 @@
+package topology
+
+import (
+	"context"
+	"time"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+	k8sappsv1 "k8s.io/api/apps/v1"
+	k8scorev1 "k8s.io/api/core/v1"
+	ctrlruntime "sigs.k8s.io/controller-runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimeutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// reconcileTeardown handles a Topology that is being deleted. The launcher deployments are scaled
+// down so that each launcher receives a sigterm and can gracefully save/destroy its containerlab
+// topology; once all launcher pods are gone (or the teardown timeout has elapsed) the teardown
+// finalizer is removed, and garbage collection takes care of the rest of the owned resources.
+func (c *Controller) reconcileTeardown(
+	ctx context.Context,
+	topology *clabernetesapisv1alpha1.Topology,
+) (ctrlruntime.Result, error) {
+	if !ctrlruntimeutil.ContainsFinalizer(
+		topology,
+		clabernetesconstants.TopologyTeardownFinalizer,
+	) {
+		return ctrlruntime.Result{}, nil
+	}
+
+	if time.Since(topology.DeletionTimestamp.Time) > clabernetesconstants.TopologyTeardownTimeout {
+		c.BaseController.Log.Warnf(
+			"topology '%s/%s' launchers did not tear down within %s, removing finalizer",
+			topology.Namespace,
+			topology.Name,
+			clabernetesconstants.TopologyTeardownTimeout,
+		)
+
+		c.Recorder.Eventf(
+			topology,
+			nil,
+			k8scorev1.EventTypeWarning,
+			clabernetesconstants.EventReasonTopologyTeardownTimeout,
+			clabernetesconstants.EventActionTeardown,
+			"launchers did not tear down within %s, removing topology anyway",
+			clabernetesconstants.TopologyTeardownTimeout,
+		)
+
+		return ctrlruntime.Result{}, c.removeTeardownFinalizer(ctx, topology)
+	}
+
+	tornDown, err := c.TopologyReconciler.TeardownLaunchers(ctx, topology)
+	if err != nil {
+		return ctrlruntime.Result{}, err
+	}
+
+	if !tornDown {
+		return ctrlruntime.Result{
+			RequeueAfter: clabernetesconstants.TopologyTeardownRequeueInterval,
+		}, nil
+	}
+
+	c.BaseController.Log.Infof(
+		"topology '%s/%s' launchers torn down, removing finalizer",
+		topology.Namespace,
+		topology.Name,
+	)
+
+	return ctrlruntime.Result{}, c.removeTeardownFinalizer(ctx, topology)
+}
+
+func (c *Controller) removeTeardownFinalizer(
+	ctx context.Context,
+	topology *clabernetesapisv1alpha1.Topology,
+) error {
+	c.TopologyReconciler.ForgetTopology(topology.UID)
+
+	ctrlruntimeutil.RemoveFinalizer(topology, clabernetesconstants.TopologyTeardownFinalizer)
+
+	return c.BaseController.Client.Update(ctx, topology)
+}
+
+// TeardownLaunchers scales down all launcher deployments belonging to the given Topology and
+// returns true once there are no launcher pods remaining for the Topology.
+func (r *Reconciler) TeardownLaunchers(
+	ctx context.Context,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+) (bool, error) {
+	ownedLabels := ctrlruntimeclient.MatchingLabels{
+		clabernetesconstants.LabelTopologyOwner: owningTopology.GetName(),
+	}
+
+	deployments := &k8sappsv1.DeploymentList{}
+
+	err := r.Client.List(
+		ctx,
+		deployments,
+		ctrlruntimeclient.InNamespace(owningTopology.GetNamespace()),
+		ownedLabels,
+	)
+	if err != nil {
+		r.Log.Criticalf("failed listing deployments for teardown, error: %s", err)
+
+		return false, err
+	}
+
+	var scaledDown int
+
+	for idx := range deployments.Items {
+		deployment := &deployments.Items[idx]
+
+		if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
+			continue
+		}
+
+		deployment.Spec.Replicas = clabernetesutil.ToPointer(int32(0))
+
+		err = r.updateObj(ctx, deployment, clabernetesconstants.KubernetesDeployment)
+		if err != nil {
+			return false, err
+		}
+
+		scaledDown++
+	}
+
+	if scaledDown > 0 {
+		r.Recorder.Eventf(
+			owningTopology,
+			nil,
+			k8scorev1.EventTypeNormal,
+			clabernetesconstants.EventReasonTopologyTeardown,
+			clabernetesconstants.EventActionTeardown,
+			"scaled down %d launcher(s) for graceful teardown",
+			scaledDown,
+		)
+	}
+
+	pods := &k8scorev1.PodList{}
+
+	err = r.Client.List(
+		ctx,
+		pods,
+		ctrlruntimeclient.InNamespace(owningTopology.GetNamespace()),
+		ownedLabels,
+	)
+	if err != nil {
+		r.Log.Criticalf("failed listing pods for teardown, error: %s", err)
+
+		return false, err
+	}
+
+	if len(pods.Items) > 0 {
+		r.Log.Debugf(
+			"waiting on %d launcher pod(s) to tear down for topology '%s/%s'",
+			len(pods.Items),
+			owningTopology.Namespace,
+			owningTopology.Name,
+		)
+
+		return false, nil
+	}
+
+	return true, nil
+}
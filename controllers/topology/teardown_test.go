@@ -0,0 +1,130 @@
+package topology_test
+
+import (
+	"fmt"
+	"testing"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	clabernetestesthelper "github.com/srl-labs/clabernetes/testhelper"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+	k8sappsv1 "k8s.io/api/apps/v1"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoevents "k8s.io/client-go/tools/events"
+	ctrlruntimeclientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestTeardownLaunchers(t *testing.T) {
+	owningTopologyName := "teardown-test"
+
+	ownedLabels := map[string]string{
+		clabernetesconstants.LabelTopologyOwner: owningTopologyName,
+	}
+
+	cases := []struct {
+		name             string
+		loadObjects      []apimachineryruntime.Object
+		expectedTornDown bool
+	}{
+		{
+			name:             "nothing-to-tear-down",
+			loadObjects:      []apimachineryruntime.Object{},
+			expectedTornDown: true,
+		},
+		{
+			name: "running-launcher",
+			loadObjects: []apimachineryruntime.Object{
+				&k8sappsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-srl1", owningTopologyName),
+						Namespace: "clabernetes",
+						Labels:    ownedLabels,
+					},
+					Spec: k8sappsv1.DeploymentSpec{
+						Replicas: clabernetesutil.ToPointer(int32(1)),
+					},
+				},
+				&k8scorev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-srl1-abcde", owningTopologyName),
+						Namespace: "clabernetes",
+						Labels:    ownedLabels,
+					},
+				},
+			},
+			expectedTornDown: false,
+		},
+		{
+			name: "scaled-down-launcher",
+			loadObjects: []apimachineryruntime.Object{
+				&k8sappsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-srl1", owningTopologyName),
+						Namespace: "clabernetes",
+						Labels:    ownedLabels,
+					},
+					Spec: k8sappsv1.DeploymentSpec{
+						Replicas: clabernetesutil.ToPointer(int32(0)),
+					},
+				},
+			},
+			expectedTornDown: true,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				fakeClient := ctrlruntimeclientfake.NewFakeClient(testCase.loadObjects...)
+
+				r := clabernetescontrollerstopology.NewReconciler(
+					&claberneteslogging.FakeInstance{},
+					fakeClient,
+					&clientgoevents.FakeRecorder{},
+					"clabernetes",
+					"clabernetes",
+					"containerd",
+					clabernetesconfig.GetFakeManager,
+				)
+
+				owningTopology := &clabernetesapisv1alpha1.Topology{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      owningTopologyName,
+						Namespace: "clabernetes",
+					},
+				}
+
+				actual, err := r.TeardownLaunchers(t.Context(), owningTopology)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if actual != testCase.expectedTornDown {
+					clabernetestesthelper.FailOutput(t, actual, testCase.expectedTornDown)
+				}
+
+				deployments := &k8sappsv1.DeploymentList{}
+
+				err = fakeClient.List(t.Context(), deployments)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				for idx := range deployments.Items {
+					deployment := &deployments.Items[idx]
+
+					if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != 0 {
+						t.Fatalf("expected deployment %q to be scaled down", deployment.Name)
+					}
+				}
+			})
+	}
+}
@@ -8,11 +8,12 @@ import (
 
 // AllocateTunnelIDs processes the given tunnels and allocates vnids. This function updates the
 // given status object by iterating over the freshly processed tunnels (as processed during a
-// reconciliation) and assigning any tunnels in the status without a vnid the next valid vnid.
+// reconciliation) and assigning any tunnels in the status without a vnid the next valid vnid. The
+// number of newly allocated (not previously used by either end of a tunnel) ids is returned.
 func AllocateTunnelIDs(
 	previousTunnels map[string][]*clabernetesapisv1alpha1.PointToPointTunnel,
 	processedTunnels map[string][]*clabernetesapisv1alpha1.PointToPointTunnel,
-) {
+) int {
 	// we want to allocate ids deterministically, so lets iterate over the maps in *order* by
 	// getting a sorted list of keys and then iterating over those
 	processedTunnelsSortedKeys := make([]string, len(processedTunnels))
@@ -32,6 +33,8 @@ func AllocateTunnelIDs(
 	// re-use things.
 	allocatedTunnelIDs := make(map[int]bool)
 
+	var newlyAllocatedCount int
+
 	for nodeName, nodeTunnels := range processedTunnels {
 		existingNodeTunnels, ok := previousTunnels[nodeName]
 		if !ok {
@@ -40,7 +43,8 @@ func AllocateTunnelIDs(
 
 		for _, newTunnel := range nodeTunnels {
 			for _, existingTunnel := range existingNodeTunnels {
-				if newTunnel.LocalInterface == existingTunnel.LocalInterface &&
+				if newTunnel.LocalNode == existingTunnel.LocalNode &&
+					newTunnel.LocalInterface == existingTunnel.LocalInterface &&
 					newTunnel.RemoteInterface == existingTunnel.RemoteInterface &&
 					newTunnel.RemoteNode == existingTunnel.RemoteNode {
 					newTunnel.TunnelID = existingTunnel.TunnelID
@@ -65,8 +69,7 @@ func AllocateTunnelIDs(
 			// if *yes* we need to re-use that vnid obviously!
 			idToAssign := findAllocatedIDIfExists(
 				nodeName,
-				tunnel.RemoteNode,
-				tunnel.LocalInterface,
+				tunnel,
 				processedTunnelsSortedKeys,
 				processedTunnels,
 			)
@@ -89,14 +92,23 @@ func AllocateTunnelIDs(
 				tunnel.TunnelID = i
 				allocatedTunnelIDs[i] = true
 
+				newlyAllocatedCount++
+
 				break
 			}
 		}
 	}
+
+	return newlyAllocatedCount
 }
 
+// findAllocatedIDIfExists returns the id of the remote end of the given tunnel (of the launcher
+// of the given node) if that has an id allocated already. Tunnels are matched by node and
+// interface rather than by launcher, as the launcher of a node group hosts the tunnels of all
+// nodes of the group.
 func findAllocatedIDIfExists(
-	nodeName, tunnelRemoteNodeName, tunnelLocalLinkName string,
+	nodeName string,
+	tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
 	sortedKeys []string,
 	processedTunnels map[string][]*clabernetesapisv1alpha1.PointToPointTunnel,
 ) int {
@@ -106,19 +118,14 @@ func findAllocatedIDIfExists(
 			continue
 		}
 
-		if tunnelRemoteNodeName != remoteNodeName {
-			// this remote node name doesnt match our current tunnels remote node name
-			// so not our remote end, next...
-			continue
-		}
-
 		for _, remoteTunnel := range processedTunnels[remoteNodeName] {
-			if remoteTunnel.RemoteNode != nodeName {
+			if remoteTunnel.LocalNode != tunnel.RemoteNode ||
+				remoteTunnel.RemoteNode != tunnel.LocalNode {
 				// tunnel not between this node pair
 				continue
 			}
 
-			if tunnelLocalLinkName != remoteTunnel.RemoteInterface {
+			if tunnel.LocalInterface != remoteTunnel.RemoteInterface {
 				// this specific tunnel does not match our local tunnel
 				continue
 			}
@@ -21,10 +21,13 @@ func TestAllocateTunnelIds(t *testing.T) {
 		name             string
 		previousTunnels  map[string][]*clabernetesapisv1alpha1.PointToPointTunnel
 		processedTunnels map[string][]*clabernetesapisv1alpha1.PointToPointTunnel
+		// expectedAllocated is the number of newly allocated tunnel ids we expect
+		expectedAllocated int
 	}{
 		{
-			name:            "simple",
-			previousTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{},
+			name:              "simple",
+			expectedAllocated: 1,
+			previousTunnels:   map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{},
 			processedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
 				"srl1": {
 					{
@@ -49,7 +52,8 @@ func TestAllocateTunnelIds(t *testing.T) {
 			},
 		},
 		{
-			name: "simple-existing-status",
+			name:              "simple-existing-status",
+			expectedAllocated: 1,
 			previousTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
 				"srl1": {
 					{
@@ -96,7 +100,8 @@ func TestAllocateTunnelIds(t *testing.T) {
 			},
 		},
 		{
-			name: "simple-already-allocated-ids",
+			name:              "simple-already-allocated-ids",
+			expectedAllocated: 0,
 			previousTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
 				"srl1": {
 					{
@@ -143,7 +148,8 @@ func TestAllocateTunnelIds(t *testing.T) {
 			},
 		},
 		{
-			name: "simple-weirdly-allocated-ids",
+			name:              "simple-weirdly-allocated-ids",
+			expectedAllocated: 0,
 			previousTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
 				"srl1": {
 					{
@@ -190,8 +196,9 @@ func TestAllocateTunnelIds(t *testing.T) {
 			},
 		},
 		{
-			name:            "meshy-links",
-			previousTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{},
+			name:              "meshy-links",
+			expectedAllocated: 5,
+			previousTunnels:   map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{},
 			processedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
 				"srl1": {
 					{
@@ -284,7 +291,8 @@ func TestAllocateTunnelIds(t *testing.T) {
 			},
 		},
 		{
-			name: "updating-tunnels",
+			name:              "updating-tunnels",
+			expectedAllocated: 3,
 			previousTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
 				"srl1": {
 					{
@@ -394,17 +402,70 @@ func TestAllocateTunnelIds(t *testing.T) {
 				},
 			},
 		},
+		{
+			// tgen1 is co-located with dut1 -- both use eth1, so the tunnels of the dut1 launcher
+			// share the local interface name and must be told apart by their local node
+			name:              "node-groups-shared-interface",
+			expectedAllocated: 2,
+			previousTunnels:   map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{},
+			processedTunnels: map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+				"dut1": {
+					{
+						TunnelID:        0,
+						LocalNode:       "dut1",
+						Destination:     "topo-1-dut2.clabernetes.svc.cluster.local",
+						RemoteNode:      "dut2",
+						LocalInterface:  "eth1",
+						RemoteInterface: "e1-1",
+					},
+					{
+						TunnelID:        0,
+						LocalNode:       "tgen1",
+						Destination:     "topo-1-dut2.clabernetes.svc.cluster.local",
+						RemoteNode:      "dut2",
+						LocalInterface:  "eth1",
+						RemoteInterface: "e1-2",
+					},
+				},
+				"dut2": {
+					{
+						TunnelID:        0,
+						LocalNode:       "dut2",
+						Destination:     "topo-1-dut1.clabernetes.svc.cluster.local",
+						RemoteNode:      "dut1",
+						LocalInterface:  "e1-1",
+						RemoteInterface: "eth1",
+					},
+					{
+						TunnelID:        0,
+						LocalNode:       "dut2",
+						Destination:     "topo-1-dut1.clabernetes.svc.cluster.local",
+						RemoteNode:      "tgen1",
+						LocalInterface:  "e1-2",
+						RemoteInterface: "eth1",
+					},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range cases {
 		t.Run(
 			testCase.name,
 			func(t *testing.T) {
-				clabernetescontrollerstopology.AllocateTunnelIDs(
+				allocated := clabernetescontrollerstopology.AllocateTunnelIDs(
 					testCase.previousTunnels,
 					testCase.processedTunnels,
 				)
 
+				if allocated != testCase.expectedAllocated {
+					t.Fatalf(
+						"expected %d newly allocated tunnel ids, got %d",
+						testCase.expectedAllocated,
+						allocated,
+					)
+				}
+
 				got := testCase.processedTunnels
 
 				if *clabernetestesthelper.Update {
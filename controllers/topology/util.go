@@ -2,6 +2,7 @@ package topology
 
 import (
 	"fmt"
+	"maps"
 
 	clabernetesapis "github.com/srl-labs/clabernetes/apis"
 	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
@@ -31,6 +32,22 @@ func ResolveGlobalVsTopologyBool(globalValue bool, topologyValue *bool) bool {
 	return globalValue
 }
 
+// ResolveNodeSelectors returns the node selectors for launchers running the given image -- the
+// global node selectors by image take precedence, if none of those match the image the topology
+// scheduling node selector is used.
+func ResolveNodeSelectors(
+	configManager clabernetesconfig.Manager,
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	imageName string,
+) map[string]string {
+	nodeSelectors := configManager.GetNodeSelectorsByImage(imageName)
+	if len(nodeSelectors) == 0 {
+		maps.Copy(nodeSelectors, owningTopology.Spec.Deployment.Scheduling.NodeSelector)
+	}
+
+	return nodeSelectors
+}
+
 // ResolveTopologyRemovePrefix returns true if the topology resource should strip the containerlab
 // topology prefix from a resource (deployment/service) name. This helper exists primarily for
 // testing reasons as in the "normal" course of operation this value would always be taken from the
@@ -0,0 +1,401 @@
+package topology
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+	clabernetesutilkne "github.com/srl-labs/clabernetes/util/kne"
+)
+
+// ValidateTopology accepts a Topology and checks that its definition can be processed -- that is,
+// that the definition parses, that links are well-formed and only reference nodes (or the
+// containerlab "host" keyword) that exist, and that all port definitions are valid. This is what
+// the validating admission webhook uses to reject broken topologies before they are ever
+// reconciled.
+func ValidateTopology(
+	logger claberneteslogging.Instance,
+	topology *clabernetesapisv1alpha1.Topology,
+) error {
+	switch {
+	case topology.Spec.Definition.Containerlab != "":
+		err := validateContainerlabDefinition(topology.Spec.Definition.Containerlab)
+		if err != nil {
+			return err
+		}
+	case topology.Spec.Definition.Kne != "":
+		err := validateKneDefinition(topology.Spec.Definition.Kne)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf(
+			"%w: topology must have either a containerlab or a kne definition",
+			claberneteserrors.ErrInvalidData,
+		)
+	}
+
+	err := validateStatusProbes(&topology.Spec.StatusProbes)
+	if err != nil {
+		return err
+	}
+
+	reconcileData, err := NewReconcileData(topology.DeepCopy())
+	if err != nil {
+		return fmt.Errorf("%w: failed loading topology status: %w", claberneteserrors.ErrParse, err)
+	}
+
+	// the webhook is served by every manager replica, not just the leader, so we cannot rely on
+	// the config manager being started -- the resolved destinations are irrelevant here anyway, we
+	// only care that processing succeeds.
+	processor, err := NewDefinitionProcessor(
+		logger,
+		topology.DeepCopy(),
+		reconcileData,
+		clabernetesconfig.GetFakeManager,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = processor.Process()
+	if err != nil {
+		return err
+	}
+
+	err = validateLinkImpairments(
+		topology.Spec.LinkImpairments,
+		reconcileData.ResolvedTunnels,
+	)
+	if err != nil {
+		return err
+	}
+
+	return validateLinkStates(
+		topology.Spec.LinkStates,
+		reconcileData.ResolvedTunnels,
+	)
+}
+
+// validateStatusProbes checks the default and all node specific probe configurations -- that ssh
+// probes have credentials (inline or via a secret) and that the exec probe expected output (if
+// set) is a valid regular expression.
+func validateStatusProbes(statusProbes *clabernetesapisv1alpha1.StatusProbes) error {
+	probeConfigurations := map[string]clabernetesapisv1alpha1.ProbeConfiguration{
+		"default": statusProbes.ProbeConfiguration,
+	}
+
+	for nodeName, probeConfiguration := range statusProbes.NodeProbeConfigurations {
+		probeConfigurations[fmt.Sprintf("node %q", nodeName)] = probeConfiguration
+	}
+
+	// iterate in a stable order so that a topology with more than one invalid probe configuration
+	// always reports the same one
+	for _, owner := range slices.Sorted(maps.Keys(probeConfigurations)) {
+		probeConfiguration := probeConfigurations[owner]
+
+		sshProbeConfiguration := probeConfiguration.SSHProbeConfiguration
+
+		if sshProbeConfiguration != nil && sshProbeConfiguration.CredentialsSecret == "" &&
+			(sshProbeConfiguration.Username == "" || sshProbeConfiguration.Password == "") {
+			return fmt.Errorf(
+				"%w: ssh probe for %s probe configuration requires a username and password or"+
+					" a credentials secret",
+				claberneteserrors.ErrInvalidData,
+				owner,
+			)
+		}
+
+		netconfProbeConfiguration := probeConfiguration.NETCONFProbeConfiguration
+
+		if netconfProbeConfiguration != nil && netconfProbeConfiguration.CredentialsSecret == "" &&
+			(netconfProbeConfiguration.Username == "" ||
+				netconfProbeConfiguration.Password == "") {
+			return fmt.Errorf(
+				"%w: netconf probe for %s probe configuration requires a username and password"+
+					" or a credentials secret",
+				claberneteserrors.ErrInvalidData,
+				owner,
+			)
+		}
+
+		if probeConfiguration.ExecProbeConfiguration == nil ||
+			probeConfiguration.ExecProbeConfiguration.ExpectedOutput == "" {
+			continue
+		}
+
+		_, err := regexp.Compile(probeConfiguration.ExecProbeConfiguration.ExpectedOutput)
+		if err != nil {
+			return fmt.Errorf(
+				"%w: invalid exec probe expected output for %s probe configuration: %w",
+				claberneteserrors.ErrInvalidData,
+				owner,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateLinkImpairments checks that the given link impairments have valid values and that each
+// of them applies to a link between launchers (that is, a link with a tunnel).
+func validateLinkImpairments(
+	linkImpairments []clabernetesapisv1alpha1.TopologyLinkImpairment,
+	resolvedTunnels map[string][]*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	for idx := range linkImpairments {
+		linkImpairment := &linkImpairments[idx]
+
+		err := validateLinkImpairment(&linkImpairment.Impairment)
+		if err != nil {
+			return fmt.Errorf("%w: link impairment for %q", err, linkImpairment.Endpoints)
+		}
+
+		if !linkMatchesAnyTunnel(linkImpairment.Endpoints, resolvedTunnels) {
+			return fmt.Errorf(
+				"%w: link impairment for %q does not match any link between launchers",
+				claberneteserrors.ErrInvalidData,
+				linkImpairment.Endpoints,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateLinkStates checks that each of the given link states matches a link between launchers.
+func validateLinkStates(
+	linkStates []clabernetesapisv1alpha1.TopologyLinkState,
+	resolvedTunnels map[string][]*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	for idx := range linkStates {
+		linkState := &linkStates[idx]
+
+		if !linkMatchesAnyTunnel(linkState.Endpoints, resolvedTunnels) {
+			return fmt.Errorf(
+				"%w: link state for %q does not match any link between launchers",
+				claberneteserrors.ErrInvalidData,
+				linkState.Endpoints,
+			)
+		}
+	}
+
+	return nil
+}
+
+// linkMatchesAnyTunnel returns true if the given "node:interface" endpoints refer to the link of
+// any of the given resolved tunnels.
+func linkMatchesAnyTunnel(
+	endpoints []string,
+	resolvedTunnels map[string][]*clabernetesapisv1alpha1.PointToPointTunnel,
+) bool {
+	for _, nodeTunnels := range resolvedTunnels {
+		for _, tunnel := range nodeTunnels {
+			if linkEndpointsMatch(
+				endpoints,
+				clabernetesapisv1alpha1.LinkEndpoint{
+					NodeName:      tunnel.LocalNode,
+					InterfaceName: tunnel.LocalInterface,
+				},
+				clabernetesapisv1alpha1.LinkEndpoint{
+					NodeName:      tunnel.RemoteNode,
+					InterfaceName: tunnel.RemoteInterface,
+				},
+			) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func validateLinkImpairment(impairment *clabernetesapisv1alpha1.LinkImpairment) error {
+	for _, duration := range []string{impairment.Delay, impairment.Jitter} {
+		if duration == "" {
+			continue
+		}
+
+		_, err := time.ParseDuration(duration)
+		if err != nil {
+			return fmt.Errorf(
+				"%w: invalid duration %q",
+				claberneteserrors.ErrInvalidData,
+				duration,
+			)
+		}
+	}
+
+	if impairment.Jitter != "" && impairment.Delay == "" {
+		return fmt.Errorf(
+			"%w: jitter requires a delay to be set",
+			claberneteserrors.ErrInvalidData,
+		)
+	}
+
+	if impairment.Loss != "" {
+		loss, err := strconv.ParseFloat(impairment.Loss, 64)
+		if err != nil || loss < 0 || loss > 100 {
+			return fmt.Errorf(
+				"%w: invalid loss percentage %q",
+				claberneteserrors.ErrInvalidData,
+				impairment.Loss,
+			)
+		}
+	}
+
+	if impairment.Rate < 0 {
+		return fmt.Errorf(
+			"%w: invalid rate %d",
+			claberneteserrors.ErrInvalidData,
+			impairment.Rate,
+		)
+	}
+
+	return nil
+}
+
+func validateContainerlabDefinition(rawDefinition string) error {
+	containerlabConfig, err := clabernetesutilcontainerlab.LoadContainerlabConfig(rawDefinition)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed parsing containerlab definition: %w",
+			claberneteserrors.ErrParse,
+			err,
+		)
+	}
+
+	if len(containerlabConfig.Topology.Nodes) == 0 {
+		return fmt.Errorf(
+			"%w: containerlab definition does not contain any nodes",
+			claberneteserrors.ErrInvalidData,
+		)
+	}
+
+	nodes := containerlabConfig.Topology.Nodes
+
+	for nodeName, nodeDefinition := range nodes {
+		if nodeDefinition == nil {
+			return fmt.Errorf(
+				"%w: node %q has an empty definition",
+				claberneteserrors.ErrInvalidData,
+				nodeName,
+			)
+		}
+
+		primaryName := parseNetworkModeContainer(nodeDefinition.NetworkMode)
+		if primaryName != "" {
+			if _, ok := nodes[primaryName]; !ok {
+				return fmt.Errorf(
+					"%w: node %q has network-mode referencing unknown node %q",
+					claberneteserrors.ErrInvalidData,
+					nodeName,
+					primaryName,
+				)
+			}
+		}
+
+		if containerlabConfig.Topology.IsSegmentNode(nodeName) &&
+			len(nodeName) > clabernetesconstants.LinuxInterfaceNameMaxLength {
+			return fmt.Errorf(
+				"%w: segment node %q name is too long, the bridge for the segment is named after"+
+					" the node so the name may be at most %d characters",
+				claberneteserrors.ErrInvalidData,
+				nodeName,
+				clabernetesconstants.LinuxInterfaceNameMaxLength,
+			)
+		}
+
+		err = validatePortDefinitions(nodeName, nodeDefinition.Ports)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = validatePortDefinitions("defaults", containerlabConfig.Topology.Defaults.Ports)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range containerlabConfig.Topology.Links {
+		endpoints, parseErr := parseLinkEndpoints(link)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		for _, endpoint := range []clabernetesapisv1alpha1.LinkEndpoint{
+			endpoints.endpointA,
+			endpoints.endpointB,
+		} {
+			if endpoint.NodeName == clabernetesconstants.HostKeyword {
+				continue
+			}
+
+			if _, ok := nodes[endpoint.NodeName]; !ok {
+				return fmt.Errorf(
+					"%w: link endpoint %q references unknown node %q",
+					claberneteserrors.ErrInvalidData,
+					fmt.Sprintf("%s:%s", endpoint.NodeName, endpoint.InterfaceName),
+					endpoint.NodeName,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePortDefinitions(owner string, portDefinitions []string) error {
+	for _, portDefinition := range portDefinitions {
+		_, err := clabernetesutilcontainerlab.ProcessPortDefinition(portDefinition)
+		if err != nil {
+			return fmt.Errorf("%w: invalid port definition for %q", err, owner)
+		}
+	}
+
+	return nil
+}
+
+func validateKneDefinition(rawDefinition string) error {
+	kneTopo, err := clabernetesutilkne.LoadKneTopology(rawDefinition)
+	if err != nil {
+		return fmt.Errorf("%w: failed parsing kne definition: %w", claberneteserrors.ErrParse, err)
+	}
+
+	nodes := make(map[string]struct{}, len(kneTopo.GetNodes()))
+
+	for _, node := range kneTopo.GetNodes() {
+		nodes[node.GetName()] = struct{}{}
+	}
+
+	if len(nodes) == 0 {
+		return fmt.Errorf(
+			"%w: kne definition does not contain any nodes",
+			claberneteserrors.ErrInvalidData,
+		)
+	}
+
+	for _, link := range kneTopo.GetLinks() {
+		for _, nodeName := range []string{link.GetANode(), link.GetZNode()} {
+			if _, ok := nodes[nodeName]; !ok {
+				return fmt.Errorf(
+					"%w: link references unknown node %q",
+					claberneteserrors.ErrInvalidData,
+					nodeName,
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,666 @@
+package topology_test
+
+import (
+	"testing"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateTopology(t *testing.T) {
+	cases := []struct {
+		name            string
+		definition      clabernetesapisv1alpha1.Definition
+		linkImpairments []clabernetesapisv1alpha1.TopologyLinkImpairment
+		linkStates      []clabernetesapisv1alpha1.TopologyLinkState
+		nodeGroups      map[string][]string
+		statusProbes    clabernetesapisv1alpha1.StatusProbes
+		expectError     bool
+	}{
+		{
+			name: "containerlab-valid",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+          ports:
+            - 60000:21/tcp
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e3-3", "host:eth3-3"]
+`,
+			},
+			expectError: false,
+		},
+		{
+			name:        "no-definition",
+			definition:  clabernetesapisv1alpha1.Definition{},
+			expectError: true,
+		},
+		{
+			name: "containerlab-bad-yaml",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: "name: [test",
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-no-topology",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: "name: test",
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-bad-link-syntax",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+        srl2:
+          kind: srl
+      links:
+        - endpoints: ["srl1-e1-1", "srl2:e1-1"]
+`,
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-unknown-link-node",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+      links:
+        - endpoints: ["srl1:e1-1", "srl99:e1-1"]
+`,
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-bad-port",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          ports:
+            - notaport
+`,
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-segment-valid",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+        lan1:
+          kind: bridge
+      links:
+        - endpoints: ["srl1:e1-1", "lan1:eth1"]
+`,
+			},
+			expectError: false,
+		},
+		{
+			name: "containerlab-segment-name-too-long",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+        a-very-long-lan-name:
+          kind: ovs-bridge
+      links:
+        - endpoints: ["srl1:e1-1", "a-very-long-lan-name:eth1"]
+`,
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-node-groups-valid",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+        srl2:
+          kind: srl
+        srl3:
+          kind: srl
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl2:e1-2", "srl3:e1-2"]
+`,
+			},
+			nodeGroups: map[string][]string{
+				"srl1": {"srl2"},
+			},
+			expectError: false,
+		},
+		{
+			name: "containerlab-node-groups-unknown-node",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+        srl2:
+          kind: srl
+        srl3:
+          kind: srl
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl2:e1-2", "srl3:e1-2"]
+`,
+			},
+			nodeGroups: map[string][]string{
+				"srl1": {"srl99"},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-node-groups-node-in-two-groups",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+        srl2:
+          kind: srl
+        srl3:
+          kind: srl
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl2:e1-2", "srl3:e1-2"]
+`,
+			},
+			nodeGroups: map[string][]string{
+				"srl1": {"srl2"},
+				"srl3": {"srl2"},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-node-groups-member-ports",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+        srl2:
+          kind: srl
+          ports:
+            - 60022:22/tcp
+        srl3:
+          kind: srl
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl2:e1-2", "srl3:e1-2"]
+`,
+			},
+			nodeGroups: map[string][]string{
+				"srl1": {"srl2"},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-node-groups-nested",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+        srl2:
+          kind: srl
+        srl3:
+          kind: srl
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl2:e1-2", "srl3:e1-2"]
+`,
+			},
+			nodeGroups: map[string][]string{
+				"srl1": {"srl2"},
+				"srl2": {"srl3"},
+			},
+			expectError: true,
+		},
+		{
+			name: "kne-valid",
+			definition: clabernetesapisv1alpha1.Definition{
+				Kne: `name: "test"
+nodes: {
+    name: "r1"
+    vendor: NOKIA
+}
+nodes: {
+    name: "r2"
+    vendor: NOKIA
+}
+links: {
+    a_node: "r1"
+    a_int: "e1-1"
+    z_node: "r2"
+    z_int: "e1-1"
+}
+`,
+			},
+			expectError: false,
+		},
+		{
+			name: "kne-unknown-link-node",
+			definition: clabernetesapisv1alpha1.Definition{
+				Kne: `name: "test"
+nodes: {
+    name: "r1"
+    vendor: NOKIA
+}
+links: {
+    a_node: "r1"
+    a_int: "e1-1"
+    z_node: "r2"
+    z_int: "e1-1"
+}
+`,
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-link-impairment-valid",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e3-3", "host:eth3-3"]
+`,
+			},
+			linkImpairments: []clabernetesapisv1alpha1.TopologyLinkImpairment{
+				{
+					Endpoints: []string{"srl2:e1-1", "srl1:e1-1"},
+					Impairment: clabernetesapisv1alpha1.LinkImpairment{
+						Delay:  "50ms",
+						Jitter: "5ms",
+						Loss:   "0.5",
+						Rate:   10000,
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "containerlab-link-impairment-unknown-link",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e3-3", "host:eth3-3"]
+`,
+			},
+			linkImpairments: []clabernetesapisv1alpha1.TopologyLinkImpairment{
+				{
+					Endpoints: []string{"srl1:e1-2", "srl2:e1-2"},
+					Impairment: clabernetesapisv1alpha1.LinkImpairment{
+						Delay: "50ms",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-link-impairment-host-link",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e3-3", "host:eth3-3"]
+`,
+			},
+			linkImpairments: []clabernetesapisv1alpha1.TopologyLinkImpairment{
+				{
+					Endpoints: []string{"srl1:e3-3", "host:eth3-3"},
+					Impairment: clabernetesapisv1alpha1.LinkImpairment{
+						Delay: "50ms",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-link-impairment-bad-loss",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e3-3", "host:eth3-3"]
+`,
+			},
+			linkImpairments: []clabernetesapisv1alpha1.TopologyLinkImpairment{
+				{
+					Endpoints: []string{"srl1:e1-1", "srl2:e1-1"},
+					Impairment: clabernetesapisv1alpha1.LinkImpairment{
+						Loss: "101",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-link-impairment-jitter-without-delay",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e3-3", "host:eth3-3"]
+`,
+			},
+			linkImpairments: []clabernetesapisv1alpha1.TopologyLinkImpairment{
+				{
+					Endpoints: []string{"srl1:e1-1", "srl2:e1-1"},
+					Impairment: clabernetesapisv1alpha1.LinkImpairment{
+						Jitter: "5ms",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-link-state-valid",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e3-3", "host:eth3-3"]
+`,
+			},
+			linkStates: []clabernetesapisv1alpha1.TopologyLinkState{
+				{
+					Endpoints: []string{"srl2:e1-1", "srl1:e1-1"},
+					State:     "down",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "containerlab-link-state-host-link",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+        srl2:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+      links:
+        - endpoints: ["srl1:e1-1", "srl2:e1-1"]
+        - endpoints: ["srl1:e3-3", "host:eth3-3"]
+`,
+			},
+			linkStates: []clabernetesapisv1alpha1.TopologyLinkState{
+				{
+					Endpoints: []string{"srl1:e3-3", "host:eth3-3"},
+					State:     "down",
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-exec-probe-valid",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+`,
+			},
+			statusProbes: clabernetesapisv1alpha1.StatusProbes{
+				NodeProbeConfigurations: map[string]clabernetesapisv1alpha1.ProbeConfiguration{
+					"srl1": {
+						ExecProbeConfiguration: &clabernetesapisv1alpha1.ExecProbeConfiguration{
+							Command:        []string{"sr_cli", "show version"},
+							ExpectedOutput: `Software Version\s+:\s+v\d+`,
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "containerlab-exec-probe-bad-expected-output",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+`,
+			},
+			statusProbes: clabernetesapisv1alpha1.StatusProbes{
+				ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+					ExecProbeConfiguration: &clabernetesapisv1alpha1.ExecProbeConfiguration{
+						Command:        []string{"sr_cli", "show version"},
+						ExpectedOutput: "Software Version(",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-ssh-probe-credentials-secret",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+`,
+			},
+			statusProbes: clabernetesapisv1alpha1.StatusProbes{
+				ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+					SSHProbeConfiguration: &clabernetesapisv1alpha1.SSHProbeConfiguration{
+						CredentialsSecret: "srl-credentials",
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "containerlab-ssh-probe-no-credentials",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+`,
+			},
+			statusProbes: clabernetesapisv1alpha1.StatusProbes{
+				NodeProbeConfigurations: map[string]clabernetesapisv1alpha1.ProbeConfiguration{
+					"srl1": {
+						SSHProbeConfiguration: &clabernetesapisv1alpha1.SSHProbeConfiguration{
+							Username: "admin",
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "containerlab-netconf-probe-credentials-secret",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+`,
+			},
+			statusProbes: clabernetesapisv1alpha1.StatusProbes{
+				ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+					NETCONFProbeConfiguration: &clabernetesapisv1alpha1.NETCONFProbeConfiguration{
+						CredentialsSecret: "srl-credentials",
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "containerlab-netconf-probe-no-credentials",
+			definition: clabernetesapisv1alpha1.Definition{
+				Containerlab: `---
+    name: test
+    topology:
+      nodes:
+        srl1:
+          kind: srl
+          image: ghcr.io/nokia/srlinux
+`,
+			},
+			statusProbes: clabernetesapisv1alpha1.StatusProbes{
+				ProbeConfiguration: clabernetesapisv1alpha1.ProbeConfiguration{
+					NETCONFProbeConfiguration: &clabernetesapisv1alpha1.NETCONFProbeConfiguration{
+						Username: "admin",
+					},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				err := clabernetescontrollerstopology.ValidateTopology(
+					&claberneteslogging.FakeInstance{},
+					&clabernetesapisv1alpha1.Topology{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "validate-test",
+							Namespace: "clabernetes",
+						},
+						Spec: clabernetesapisv1alpha1.TopologySpec{
+							Definition:      testCase.definition,
+							LinkImpairments: testCase.linkImpairments,
+							LinkStates:      testCase.linkStates,
+							Deployment: clabernetesapisv1alpha1.Deployment{
+								NodeGroups: testCase.nodeGroups,
+							},
+							StatusProbes: testCase.statusProbes,
+						},
+					},
+				)
+
+				if testCase.expectError && err == nil {
+					t.Fatal("expected validation error but got none")
+				}
+
+				if !testCase.expectError && err != nil {
+					t.Fatalf("expected no validation error but got: %s", err)
+				}
+			})
+	}
+}
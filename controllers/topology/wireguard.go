@@ -0,0 +1,303 @@
+package topology
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	clabernetesutilkubernetes "github.com/srl-labs/clabernetes/util/kubernetes"
+	"golang.org/x/crypto/curve25519"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	wireGuardPrivateKeySuffix = ".key"
+	wireGuardAddressSuffix    = ".address"
+)
+
+// WireGuardSecretName returns the name of the secret holding the wireguard keys (and addresses) of
+// the launchers of the given topology.
+func WireGuardSecretName(owningTopology *clabernetesapisv1alpha1.Topology) string {
+	return fmt.Sprintf("%s-wireguard", owningTopology.GetName())
+}
+
+// WireGuardPrivateKeySecretKey returns the key of the secret data holding the wireguard private
+// key of the given launcher (node).
+func WireGuardPrivateKeySecretKey(nodeName string) string {
+	return nodeName + wireGuardPrivateKeySuffix
+}
+
+func wireGuardAddressSecretKey(nodeName string) string {
+	return nodeName + wireGuardAddressSuffix
+}
+
+func generateWireGuardPrivateKey() (string, error) {
+	privateKey := make([]byte, curve25519.ScalarSize)
+
+	_, err := rand.Read(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	// clamp the key like "wg genkey" does
+	privateKey[0] &= 248
+	privateKey[31] = (privateKey[31] & 127) | 64
+
+	return base64.StdEncoding.EncodeToString(privateKey), nil
+}
+
+// WireGuardPublicKey returns the (base64 encoded) wireguard public key for the given (base64
+// encoded) wireguard private key.
+func WireGuardPublicKey(privateKey string) (string, error) {
+	privateKeyBytes, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	publicKey, err := curve25519.X25519(privateKeyBytes, curve25519.Basepoint)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(publicKey), nil
+}
+
+// RenderWireGuardSecretData renders the data of the wireguard secret for the given launchers
+// (nodes). The private key and address of launchers that exist in the given existing secret data
+// are kept as is, launchers that do not exist yet get a freshly generated private key and the
+// lowest free address of the wireguard network.
+func RenderWireGuardSecretData(
+	existingData map[string][]byte,
+	nodeNames []string,
+) (map[string][]byte, error) {
+	data := make(map[string][]byte)
+
+	usedAddresses := make(map[netip.Addr]bool)
+
+	var newNodeNames []string
+
+	for _, nodeName := range nodeNames {
+		privateKey, keyOk := existingData[WireGuardPrivateKeySecretKey(nodeName)]
+		address, addressOk := existingData[wireGuardAddressSecretKey(nodeName)]
+
+		if !keyOk || !addressOk {
+			newNodeNames = append(newNodeNames, nodeName)
+
+			continue
+		}
+
+		parsedAddress, err := netip.ParseAddr(string(address))
+		if err != nil || usedAddresses[parsedAddress] {
+			newNodeNames = append(newNodeNames, nodeName)
+
+			continue
+		}
+
+		usedAddresses[parsedAddress] = true
+
+		data[WireGuardPrivateKeySecretKey(nodeName)] = privateKey
+		data[wireGuardAddressSecretKey(nodeName)] = address
+	}
+
+	// sort so address allocation is deterministic for any given set of new launchers
+	slices.Sort(newNodeNames)
+
+	network := netip.MustParsePrefix(clabernetesconstants.WireGuardNetwork)
+
+	nextAddress := network.Addr().Next()
+
+	for _, nodeName := range newNodeNames {
+		for usedAddresses[nextAddress] {
+			nextAddress = nextAddress.Next()
+		}
+
+		if !network.Contains(nextAddress) {
+			return nil, fmt.Errorf(
+				"%w: no free address left in wireguard network %q",
+				claberneteserrors.ErrReconcile,
+				clabernetesconstants.WireGuardNetwork,
+			)
+		}
+
+		privateKey, err := generateWireGuardPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		usedAddresses[nextAddress] = true
+
+		data[WireGuardPrivateKeySecretKey(nodeName)] = []byte(privateKey)
+		data[wireGuardAddressSecretKey(nodeName)] = []byte(nextAddress.String())
+	}
+
+	return data, nil
+}
+
+// WireGuardSecretReconciler is a subcomponent of the "TopologyReconciler" but is exposed for
+// testing purposes. This is the component responsible for rendering/validating the secret holding
+// the wireguard keys of the launchers of a clabernetes topology resource.
+type WireGuardSecretReconciler struct {
+	log                 claberneteslogging.Instance
+	configManagerGetter clabernetesconfig.ManagerGetterFunc
+}
+
+// NewWireGuardSecretReconciler returns an instance of WireGuardSecretReconciler.
+func NewWireGuardSecretReconciler(
+	log claberneteslogging.Instance,
+	configManagerGetter clabernetesconfig.ManagerGetterFunc,
+) *WireGuardSecretReconciler {
+	return &WireGuardSecretReconciler{
+		log:                 log,
+		configManagerGetter: configManagerGetter,
+	}
+}
+
+// Render accepts the owning topology and the (already rendered) secret data and renders the final
+// wireguard secret for the topology.
+func (r *WireGuardSecretReconciler) Render(
+	owningTopology *clabernetesapisv1alpha1.Topology,
+	data map[string][]byte,
+) *k8scorev1.Secret {
+	owningTopologyName := owningTopology.GetName()
+
+	annotations, globalLabels := r.configManagerGetter().GetAllMetadata()
+
+	labels := map[string]string{
+		clabernetesconstants.LabelApp:           clabernetesconstants.Clabernetes,
+		clabernetesconstants.LabelName:          owningTopologyName,
+		clabernetesconstants.LabelTopologyOwner: owningTopologyName,
+		clabernetesconstants.LabelTopologyKind:  GetTopologyKind(owningTopology),
+	}
+
+	maps.Copy(labels, globalLabels)
+
+	return &k8scorev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        WireGuardSecretName(owningTopology),
+			Namespace:   owningTopology.GetNamespace(),
+			Annotations: annotations,
+			Labels:      labels,
+		},
+		Type: k8scorev1.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+// Conforms checks if the existingSecret conforms with the renderedSecret.
+func (r *WireGuardSecretReconciler) Conforms(
+	existingSecret,
+	renderedSecret *k8scorev1.Secret,
+	expectedOwnerUID apimachinerytypes.UID,
+) bool {
+	if !reflect.DeepEqual(existingSecret.Data, renderedSecret.Data) {
+		return false
+	}
+
+	if !clabernetesutilkubernetes.ExistingMapStringStringContainsAllExpectedKeyValues(
+		existingSecret.ObjectMeta.Annotations,
+		renderedSecret.ObjectMeta.Annotations,
+	) {
+		return false
+	}
+
+	if !clabernetesutilkubernetes.ExistingMapStringStringContainsAllExpectedKeyValues(
+		existingSecret.ObjectMeta.Labels,
+		renderedSecret.ObjectMeta.Labels,
+	) {
+		return false
+	}
+
+	if len(existingSecret.ObjectMeta.OwnerReferences) != 1 {
+		// we should have only one owner reference, the topology
+		return false
+	}
+
+	if existingSecret.ObjectMeta.OwnerReferences[0].UID != expectedOwnerUID {
+		// owner ref uid is not us
+		return false
+	}
+
+	return true
+}
+
+// ResolveWireGuardTunnels sets the wireguard peering information on all the given tunnels (keyed
+// by launcher/node name) based on the given wireguard secret data.
+func ResolveWireGuardTunnels(
+	tunnels map[string][]*clabernetesapisv1alpha1.PointToPointTunnel,
+	secretData map[string][]byte,
+) error {
+	// tunnels reference (local and remote) nodes, but keys belong to the launchers which may host
+	// more than one node, so figure out which launcher hosts which node first
+	nodeLaunchers := make(map[string]string)
+
+	for launcherName, launcherTunnels := range tunnels {
+		nodeLaunchers[launcherName] = launcherName
+
+		for _, tunnel := range launcherTunnels {
+			nodeLaunchers[tunnel.LocalNode] = launcherName
+		}
+	}
+
+	publicKeys := make(map[string]string)
+
+	for key, value := range secretData {
+		launcherName, ok := strings.CutSuffix(key, wireGuardPrivateKeySuffix)
+		if !ok {
+			continue
+		}
+
+		publicKey, err := WireGuardPublicKey(string(value))
+		if err != nil {
+			return fmt.Errorf(
+				"%w: invalid wireguard private key for node %q: %w",
+				claberneteserrors.ErrReconcile,
+				launcherName,
+				err,
+			)
+		}
+
+		publicKeys[launcherName] = publicKey
+	}
+
+	for launcherName, launcherTunnels := range tunnels {
+		for _, tunnel := range launcherTunnels {
+			remoteLauncherName, ok := nodeLaunchers[tunnel.RemoteNode]
+			if !ok {
+				return fmt.Errorf(
+					"%w: cannot find launcher for remote node %q",
+					claberneteserrors.ErrReconcile,
+					tunnel.RemoteNode,
+				)
+			}
+
+			remotePublicKey, ok := publicKeys[remoteLauncherName]
+			if !ok {
+				return fmt.Errorf(
+					"%w: no wireguard key for node %q",
+					claberneteserrors.ErrReconcile,
+					remoteLauncherName,
+				)
+			}
+
+			tunnel.WireGuard = &clabernetesapisv1alpha1.PointToPointTunnelWireGuard{
+				LocalAddress:    string(secretData[wireGuardAddressSecretKey(launcherName)]),
+				RemoteAddress:   string(secretData[wireGuardAddressSecretKey(remoteLauncherName)]),
+				RemotePublicKey: remotePublicKey,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package topology_test
+
+import (
+	"testing"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+)
+
+func TestRenderWireGuardSecretData(t *testing.T) {
+	cases := []struct {
+		name              string
+		existingData      map[string][]byte
+		nodeNames         []string
+		expectedAddresses map[string]string
+		expectedKeptKeys  []string
+	}{
+		{
+			name:      "new",
+			nodeNames: []string{"srl2", "srl1"},
+			expectedAddresses: map[string]string{
+				"srl1.address": "198.18.0.1",
+				"srl2.address": "198.18.0.2",
+			},
+		},
+		{
+			name: "keep-existing",
+			existingData: map[string][]byte{
+				"srl1.key":     []byte("YWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWE="),
+				"srl1.address": []byte("198.18.0.2"),
+				"srl3.key":     []byte("Y2NjY2NjY2NjY2NjY2NjY2NjY2NjY2NjY2NjY2NjY2M="),
+				"srl3.address": []byte("198.18.0.1"),
+			},
+			nodeNames: []string{"srl1", "srl2"},
+			expectedAddresses: map[string]string{
+				"srl1.address": "198.18.0.2",
+				"srl2.address": "198.18.0.1",
+			},
+			expectedKeptKeys: []string{"srl1.key"},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				actual, err := clabernetescontrollerstopology.RenderWireGuardSecretData(
+					testCase.existingData,
+					testCase.nodeNames,
+				)
+				if err != nil {
+					t.Fatalf("failed rendering wireguard secret data, error: %s", err)
+				}
+
+				if len(actual) != len(testCase.nodeNames)*2 {
+					t.Fatalf("expected %d secret data keys, got %d", len(testCase.nodeNames)*2, len(actual))
+				}
+
+				for key, expectedAddress := range testCase.expectedAddresses {
+					if string(actual[key]) != expectedAddress {
+						t.Fatalf(
+							"expected %q to be %q, got %q", key, expectedAddress, actual[key],
+						)
+					}
+				}
+
+				for _, key := range testCase.expectedKeptKeys {
+					if string(actual[key]) != string(testCase.existingData[key]) {
+						t.Fatalf("expected %q to be kept, got %q", key, actual[key])
+					}
+				}
+
+				for _, nodeName := range testCase.nodeNames {
+					_, err = clabernetescontrollerstopology.WireGuardPublicKey(
+						string(actual[nodeName+".key"]),
+					)
+					if err != nil {
+						t.Fatalf("invalid private key for %q, error: %s", nodeName, err)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestResolveWireGuardTunnels(t *testing.T) {
+	secretData, err := clabernetescontrollerstopology.RenderWireGuardSecretData(
+		nil,
+		[]string{"srl1", "srl2"},
+	)
+	if err != nil {
+		t.Fatalf("failed rendering wireguard secret data, error: %s", err)
+	}
+
+	srl2PublicKey, err := clabernetescontrollerstopology.WireGuardPublicKey(
+		string(secretData["srl2.key"]),
+	)
+	if err != nil {
+		t.Fatalf("failed deriving public key, error: %s", err)
+	}
+
+	tunnels := map[string][]*clabernetesapisv1alpha1.PointToPointTunnel{
+		"srl1": {
+			{
+				LocalNode:       "srl1",
+				LocalInterface:  "e1-1",
+				RemoteNode:      "srl2",
+				RemoteInterface: "e1-1",
+			},
+		},
+		"srl2": {
+			{
+				LocalNode:       "srl2",
+				LocalInterface:  "e1-1",
+				RemoteNode:      "srl1",
+				RemoteInterface: "e1-1",
+			},
+		},
+	}
+
+	err = clabernetescontrollerstopology.ResolveWireGuardTunnels(tunnels, secretData)
+	if err != nil {
+		t.Fatalf("failed resolving wireguard tunnels, error: %s", err)
+	}
+
+	expected := clabernetesapisv1alpha1.PointToPointTunnelWireGuard{
+		LocalAddress:    "198.18.0.1",
+		RemoteAddress:   "198.18.0.2",
+		RemotePublicKey: srl2PublicKey,
+	}
+
+	actual := tunnels["srl1"][0].WireGuard
+	if actual == nil || *actual != expected {
+		t.Fatalf("expected wireguard info %+v, got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,204 @@
+package topologysnapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	clabernetesutilkubernetes "github.com/srl-labs/clabernetes/util/kubernetes"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// maxConfigMapDataBytes is the (approximate) max size of the data we are willing to store in a
+	// single node configmap -- objects in etcd are limited to 1MiB, so we leave a bit of room for
+	// the object metadata.
+	maxConfigMapDataBytes = 1000 * 1024
+)
+
+var invalidConfigMapKeyCharsPattern = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+// SnapshotCommand returns the command to execute in the container of the given launcher in order
+// to capture the configuration of the given nodes -- the launcher node itself and/or the other
+// nodes of its node group. The command runs "containerlab save" (with the given launcher runtime)
+// for the (sub) topology of the launcher and then writes a gzipped tar archive of every file in
+// the node directories that was written by the save operation to stdout, the archive paths are
+// prefixed with the node name.
+func SnapshotCommand(launcherName, launcherRuntime string, nodeNames []string) []string {
+	saveCommand := "containerlab save -t topo.clab.yaml"
+
+	if launcherRuntime == clabernetesconstants.LauncherRuntimePodman {
+		saveCommand += " --runtime " + clabernetesconstants.LauncherRuntimePodman
+	}
+
+	script := strings.Join(
+		[]string{
+			"set -e",
+			"marker=$(mktemp)",
+			saveCommand + " >&2",
+			fmt.Sprintf("cd /clabernetes/clab-clabernetes-%s", launcherName),
+			fmt.Sprintf(
+				`find %s -type f -newer "${marker}" | tar -czf - -T -`,
+				strings.Join(nodeNames, " "),
+			),
+		},
+		"\n",
+	)
+
+	return []string{"sh", "-c", script}
+}
+
+// NodeConfigMapName returns the name of the configmap holding the configuration artifacts of the
+// given node for the given TopologySnapshot.
+func NodeConfigMapName(topologySnapshotName, nodeName string) string {
+	return clabernetesutilkubernetes.SafeConcatNameKubernetes(topologySnapshotName, nodeName)
+}
+
+// RenderConfigMap renders the configmap holding the configuration artifacts of a node from the
+// gzipped tar archive output of the SnapshotCommand. Only the files in the directory of the given
+// node are considered, the archive may also hold the files of the other nodes of the launcher. The
+// artifacts (file path, relative to the node directory, to configmap key mapping) stored in the
+// configmap are returned alongside the configmap.
+func RenderConfigMap(
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+	nodeName string,
+	archive []byte,
+	configManagerGetter clabernetesconfig.ManagerGetterFunc,
+) (*k8scorev1.ConfigMap, []clabernetesapisv1alpha1.TopologySnapshotArtifact, error) {
+	annotations, globalLabels := configManagerGetter().GetAllMetadata()
+
+	labels := map[string]string{
+		clabernetesconstants.LabelApp:              clabernetesconstants.Clabernetes,
+		clabernetesconstants.LabelName:             topologySnapshot.Name,
+		clabernetesconstants.LabelTopologySnapshot: topologySnapshot.Name,
+		clabernetesconstants.LabelTopologyNode:     nodeName,
+	}
+
+	maps.Copy(labels, globalLabels)
+
+	configMap := &k8scorev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        NodeConfigMapName(topologySnapshot.Name, nodeName),
+			Namespace:   topologySnapshot.Namespace,
+			Annotations: annotations,
+			Labels:      labels,
+		},
+		Data:       map[string]string{},
+		BinaryData: map[string][]byte{},
+	}
+
+	artifacts := make([]clabernetesapisv1alpha1.TopologySnapshotArtifact, 0)
+
+	if len(archive) == 0 {
+		return configMap, artifacts, nil
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+
+	tarReader := tar.NewReader(gzipReader)
+
+	var totalBytes int
+
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		artifactPath, ok := strings.CutPrefix(path.Clean(header.Name), nodeName+"/")
+		if !ok {
+			// belongs to another node of the launcher
+			continue
+		}
+
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		totalBytes += len(content)
+
+		if totalBytes > maxConfigMapDataBytes {
+			return nil, nil, fmt.Errorf(
+				"%w: configuration artifacts of node %q exceed the max configmap size",
+				claberneteserrors.ErrInvalidData,
+				nodeName,
+			)
+		}
+
+		key := configMapKey(configMap, artifactPath)
+
+		if utf8.Valid(content) {
+			configMap.Data[key] = string(content)
+		} else {
+			configMap.BinaryData[key] = content
+		}
+
+		artifacts = append(
+			artifacts,
+			clabernetesapisv1alpha1.TopologySnapshotArtifact{
+				Path: artifactPath,
+				Key:  key,
+			},
+		)
+	}
+
+	slices.SortFunc(
+		artifacts,
+		func(a, b clabernetesapisv1alpha1.TopologySnapshotArtifact) int {
+			return strings.Compare(a.Path, b.Path)
+		},
+	)
+
+	return configMap, artifacts, nil
+}
+
+// configMapKey returns a valid (and not yet used) configmap key for the given artifact path.
+func configMapKey(configMap *k8scorev1.ConfigMap, artifactPath string) string {
+	baseKey := invalidConfigMapKeyCharsPattern.ReplaceAllString(
+		strings.ReplaceAll(artifactPath, "/", "__"),
+		"_",
+	)
+
+	key := baseKey
+
+	for idx := 1; ; idx++ {
+		_, dataOk := configMap.Data[key]
+		_, binaryDataOk := configMap.BinaryData[key]
+
+		if !dataOk && !binaryDataOk {
+			return key
+		}
+
+		key = fmt.Sprintf("%s-%d", baseKey, idx)
+	}
+}
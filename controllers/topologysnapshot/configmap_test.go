@@ -0,0 +1,272 @@
+package topologysnapshot_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetescontrollerstopologysnapshot "github.com/srl-labs/clabernetes/controllers/topologysnapshot"
+	clabernetestesthelper "github.com/srl-labs/clabernetes/testhelper"
+	k8scorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const renderConfigMapTestName = "configmap/render-config-map"
+
+func buildArchive(t *testing.T, files map[string][]byte, fileOrder []string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for _, name := range fileOrder {
+		err := tarWriter.WriteHeader(
+			&tar.Header{
+				Name:     name,
+				Mode:     0o644,
+				Size:     int64(len(files[name])),
+				Typeflag: tar.TypeReg,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = tarWriter.Write(files[name])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := tarWriter.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gzipWriter.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+// TestRenderConfigMap ensures that we properly render the node configmap (and artifact list) from
+// the archive captured in a launcher pod.
+func TestRenderConfigMap(t *testing.T) {
+	cases := []struct {
+		name      string
+		nodeName  string
+		files     map[string][]byte
+		fileOrder []string
+	}{
+		{
+			name:      "empty",
+			nodeName:  "srl1",
+			files:     map[string][]byte{},
+			fileOrder: []string{},
+		},
+		{
+			name:     "srl-config",
+			nodeName: "srl1",
+			files: map[string][]byte{
+				"./srl1/config/config.json": []byte(`{"system": {}}`),
+				"./srl1/config/checkpoint/checkpoint-0.json": []byte(
+					`{"system": {"name": "srl1"}}`,
+				),
+			},
+			fileOrder: []string{
+				"./srl1/config/config.json",
+				"./srl1/config/checkpoint/checkpoint-0.json",
+			},
+		},
+		{
+			name:     "node-group",
+			nodeName: "srl1",
+			files: map[string][]byte{
+				"./srl1/config/config.json": []byte(`{"system": {}}`),
+				"./srl10/config/config.json": []byte(
+					`{"system": {"name": "srl10"}}`,
+				),
+				"./srl2/config/config.json": []byte(`{"system": {"name": "srl2"}}`),
+			},
+			fileOrder: []string{
+				"./srl1/config/config.json",
+				"./srl10/config/config.json",
+				"./srl2/config/config.json",
+			},
+		},
+		{
+			name:     "binary-and-colliding-keys",
+			nodeName: "ceos1",
+			files: map[string][]byte{
+				"./ceos1/flash/startup-config": []byte("hostname ceos1\n"),
+				"./ceos1/flash_startup-config": []byte("hostname ceos1-too\n"),
+				"./ceos1/flash:startup-config": []byte("hostname ceos1-also\n"),
+				"./ceos1/flash/if-wait.bin":    {0xff, 0xfe, 0x00, 0x01},
+			},
+			fileOrder: []string{
+				"./ceos1/flash/startup-config",
+				"./ceos1/flash_startup-config",
+				"./ceos1/flash:startup-config",
+				"./ceos1/flash/if-wait.bin",
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				topologySnapshot := &clabernetesapisv1alpha1.TopologySnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-snapshot",
+						Namespace: "nowhere",
+					},
+					Spec: clabernetesapisv1alpha1.TopologySnapshotSpec{
+						TopologyName: "test-topology",
+					},
+				}
+
+				var archive []byte
+
+				if len(testCase.fileOrder) > 0 {
+					archive = buildArchive(t, testCase.files, testCase.fileOrder)
+				}
+
+				gotConfigMap, gotArtifacts, err := clabernetescontrollerstopologysnapshot.RenderConfigMap(
+					topologySnapshot,
+					testCase.nodeName,
+					archive,
+					clabernetesconfig.GetFakeManager,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got := struct {
+					ConfigMap *k8scorev1.ConfigMap                               `json:"configMap"`
+					Artifacts []clabernetesapisv1alpha1.TopologySnapshotArtifact `json:"artifacts"`
+				}{
+					ConfigMap: gotConfigMap,
+					Artifacts: gotArtifacts,
+				}
+
+				if *clabernetestesthelper.Update {
+					clabernetestesthelper.WriteTestFixtureJSON(
+						t,
+						fmt.Sprintf("golden/%s/%s.json", renderConfigMapTestName, testCase.name),
+						got,
+					)
+				}
+
+				want := got
+
+				want.ConfigMap = nil
+				want.Artifacts = nil
+
+				err = json.Unmarshal(
+					clabernetestesthelper.ReadTestFixtureFile(
+						t,
+						fmt.Sprintf("golden/%s/%s.json", renderConfigMapTestName, testCase.name),
+					),
+					&want,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				clabernetestesthelper.MarshaledEqual(t, got, want)
+			},
+		)
+	}
+}
+
+// TestRenderConfigMapTooLarge ensures that we refuse to render node configmaps that would exceed
+// the max size of a configmap.
+func TestRenderConfigMapTooLarge(t *testing.T) {
+	files := map[string][]byte{
+		"./srl1/config/one.json": bytes.Repeat([]byte("a"), 600*1024),
+		"./srl1/config/two.json": bytes.Repeat([]byte("b"), 600*1024),
+	}
+
+	_, _, err := clabernetescontrollerstopologysnapshot.RenderConfigMap(
+		&clabernetesapisv1alpha1.TopologySnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-snapshot",
+				Namespace: "nowhere",
+			},
+		},
+		"srl1",
+		buildArchive(t, files, []string{"./srl1/config/one.json", "./srl1/config/two.json"}),
+		clabernetesconfig.GetFakeManager,
+	)
+	if err == nil {
+		t.Fatal("expected error rendering oversized configmap, got nil")
+	}
+}
+
+// TestSnapshotCommand ensures that the snapshot command saves the topology with the runtime of the
+// launcher and archives the directories of all the given nodes.
+func TestSnapshotCommand(t *testing.T) {
+	cases := []struct {
+		name            string
+		launcherName    string
+		launcherRuntime string
+		nodeNames       []string
+		expectedScript  string
+	}{
+		{
+			name:         "docker",
+			launcherName: "srl1",
+			nodeNames:    []string{"srl1"},
+			expectedScript: `set -e
+marker=$(mktemp)
+containerlab save -t topo.clab.yaml >&2
+cd /clabernetes/clab-clabernetes-srl1
+find srl1 -type f -newer "${marker}" | tar -czf - -T -`,
+		},
+		{
+			name:            "podman-node-group",
+			launcherName:    "srl1",
+			launcherRuntime: "podman",
+			nodeNames:       []string{"srl1", "srl2"},
+			expectedScript: `set -e
+marker=$(mktemp)
+containerlab save -t topo.clab.yaml --runtime podman >&2
+cd /clabernetes/clab-clabernetes-srl1
+find srl1 srl2 -type f -newer "${marker}" | tar -czf - -T -`,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				actual := clabernetescontrollerstopologysnapshot.SnapshotCommand(
+					testCase.launcherName,
+					testCase.launcherRuntime,
+					testCase.nodeNames,
+				)
+
+				expected := []string{"sh", "-c", testCase.expectedScript}
+
+				if !reflect.DeepEqual(actual, expected) {
+					clabernetestesthelper.FailOutput(t, actual, expected)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,75 @@
+package topologysnapshot
+
+import (
+	"fmt"
+
+	clabernetesapis "github.com/srl-labs/clabernetes/apis"
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetescontrollers "github.com/srl-labs/clabernetes/controllers"
+	clabernetesmanagertypes "github.com/srl-labs/clabernetes/manager/types"
+	"k8s.io/client-go/kubernetes"
+	clientgoevents "k8s.io/client-go/tools/events"
+	ctrlruntime "sigs.k8s.io/controller-runtime"
+	ctrlruntimecontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+const (
+	concurrentReconciles = 5
+)
+
+// NewController returns a new Controller.
+func NewController(
+	clabernetes clabernetesmanagertypes.Clabernetes,
+) clabernetescontrollers.Controller {
+	ctx := clabernetes.GetContext()
+
+	baseController := clabernetescontrollers.NewBaseController(
+		ctx,
+		clabernetesapis.TopologySnapshot,
+		clabernetes.GetAppName(),
+		clabernetes.GetKubeConfig(),
+		clabernetes.GetCtrlRuntimeClient(),
+	)
+
+	c := &Controller{
+		BaseController: baseController,
+		KubeClient:     clabernetes.GetKubeClient(),
+		Recorder: clabernetes.GetCtrlRuntimeMgr().GetEventRecorder(
+			fmt.Sprintf(
+				"%s-%s-controller",
+				clabernetes.GetAppName(),
+				clabernetesapis.TopologySnapshot,
+			),
+		),
+	}
+
+	return c
+}
+
+// Controller is the TopologySnapshot controller object.
+type Controller struct {
+	*clabernetescontrollers.BaseController
+
+	// the *uncached* (non ctrl-runtime client) so we can exec into launcher pods
+	KubeClient *kubernetes.Clientset
+
+	// Recorder is the event recorder used to emit kubernetes events for TopologySnapshots.
+	Recorder clientgoevents.EventRecorder
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrlruntime.Manager) error {
+	c.BaseController.Log.Infof(
+		"setting up %s controller with manager",
+		clabernetesapis.TopologySnapshot,
+	)
+
+	return ctrlruntime.NewControllerManagedBy(mgr).
+		WithOptions(
+			ctrlruntimecontroller.Options{
+				MaxConcurrentReconciles: concurrentReconciles,
+			},
+		).
+		For(&clabernetesapisv1alpha1.TopologySnapshot{}).
+		Complete(c)
+}
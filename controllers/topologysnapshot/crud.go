@@ -0,0 +1,56 @@
+package topologysnapshot
+
+import (
+	"context"
+
+	clabernetesapis "github.com/srl-labs/clabernetes/apis"
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	ctrlruntime "sigs.k8s.io/controller-runtime"
+)
+
+// getTopologySnapshotFromReq fetches the reconcile target TopologySnapshot from the Request.
+func (c *Controller) getTopologySnapshotFromReq(
+	ctx context.Context,
+	req ctrlruntime.Request,
+) (*clabernetesapisv1alpha1.TopologySnapshot, error) {
+	topologySnapshot := &clabernetesapisv1alpha1.TopologySnapshot{}
+
+	err := c.BaseController.Client.Get(
+		ctx,
+		apimachinerytypes.NamespacedName{
+			Namespace: req.Namespace,
+			Name:      req.Name,
+		},
+		topologySnapshot,
+	)
+
+	return topologySnapshot, err
+}
+
+func (c *Controller) update(
+	ctx context.Context,
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+) error {
+	c.Log.Debugf(
+		"updating %s '%s/%s'",
+		clabernetesapis.TopologySnapshot,
+		topologySnapshot.GetNamespace(),
+		topologySnapshot.GetName(),
+	)
+
+	err := c.Client.Update(ctx, topologySnapshot)
+	if err != nil {
+		c.Log.Criticalf(
+			"failed updating %s '%s/%s' error: %s",
+			clabernetesapis.TopologySnapshot,
+			topologySnapshot.GetNamespace(),
+			topologySnapshot.GetName(),
+			err,
+		)
+
+		return err
+	}
+
+	return nil
+}
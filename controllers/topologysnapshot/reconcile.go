@@ -0,0 +1,552 @@
+package topologysnapshot
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	clabernetesapis "github.com/srl-labs/clabernetes/apis"
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+	clabernetesutilkubernetes "github.com/srl-labs/clabernetes/util/kubernetes"
+	k8scorev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	ctrlruntime "sigs.k8s.io/controller-runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimeutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Reconcile handles reconciliation for this controller.
+func (c *Controller) Reconcile(
+	ctx context.Context,
+	req ctrlruntime.Request,
+) (ctrlruntime.Result, error) {
+	c.BaseController.LogReconcileStart(req)
+
+	topologySnapshot, err := c.getTopologySnapshotFromReq(ctx, req)
+	if err != nil {
+		if apimachineryerrors.IsNotFound(err) {
+			c.BaseController.LogReconcileCompleteObjectNotExist(req)
+
+			return ctrlruntime.Result{}, nil
+		}
+
+		c.BaseController.LogReconcileFailedGettingObject(req, err)
+
+		return ctrlruntime.Result{}, err
+	}
+
+	if topologySnapshot.DeletionTimestamp != nil {
+		return ctrlruntime.Result{}, nil
+	}
+
+	switch topologySnapshot.Status.Phase { //nolint:exhaustive
+	case clabernetesapisv1alpha1.TopologySnapshotPhaseCompleted,
+		clabernetesapisv1alpha1.TopologySnapshotPhaseFailed:
+		// snapshots are one-shot, nothing else to do once they are done
+		c.BaseController.LogReconcileCompleteSuccess(req)
+
+		return ctrlruntime.Result{}, nil
+	case clabernetesapisv1alpha1.TopologySnapshotPhaseRunning:
+		// already started, continue with any nodes that have not been processed yet
+	default:
+		err = c.start(ctx, topologySnapshot)
+		if err != nil {
+			return ctrlruntime.Result{}, err
+		}
+
+		if topologySnapshot.Status.Phase == clabernetesapisv1alpha1.TopologySnapshotPhaseFailed {
+			return ctrlruntime.Result{}, nil
+		}
+	}
+
+	topology, err := c.getTopology(ctx, topologySnapshot)
+	if err != nil {
+		if apimachineryerrors.IsNotFound(err) {
+			return ctrlruntime.Result{}, c.fail(
+				ctx,
+				topologySnapshot,
+				fmt.Sprintf("topology %q does not exist", topologySnapshot.Spec.TopologyName),
+			)
+		}
+
+		return ctrlruntime.Result{}, err
+	}
+
+	// each reconcile captures the nodes of (at most) a single launcher so that a single
+	// reconcile is bounded by the snapshot timeout of one launcher, we requeue until all
+	// launchers have been processed
+	launcherName, nodeNames := nextPendingLauncher(topologySnapshot, topology.Status.Configs)
+	if launcherName != "" {
+		c.snapshotLauncher(
+			ctx,
+			topologySnapshot,
+			launcherName,
+			topology.Spec.Deployment.LauncherRuntime,
+			nodeNames,
+		)
+
+		err = c.update(ctx, topologySnapshot)
+		if err != nil {
+			return ctrlruntime.Result{}, err
+		}
+
+		return ctrlruntime.Result{Requeue: true}, nil
+	}
+
+	failOrphanedNodes(topologySnapshot)
+
+	err = c.complete(ctx, topologySnapshot)
+	if err != nil {
+		return ctrlruntime.Result{}, err
+	}
+
+	c.BaseController.LogReconcileCompleteSuccess(req)
+
+	return ctrlruntime.Result{}, nil
+}
+
+// start marks the snapshot as running and populates the per node status from the nodes of the
+// snapshotted Topology. If the Topology does not exist (or has not been reconciled yet) the
+// snapshot is marked as failed.
+func (c *Controller) start(
+	ctx context.Context,
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+) error {
+	topology, err := c.getTopology(ctx, topologySnapshot)
+	if err != nil {
+		if apimachineryerrors.IsNotFound(err) {
+			return c.fail(
+				ctx,
+				topologySnapshot,
+				fmt.Sprintf("topology %q does not exist", topologySnapshot.Spec.TopologyName),
+			)
+		}
+
+		return err
+	}
+
+	if len(topology.Status.Configs) == 0 {
+		return c.fail(
+			ctx,
+			topologySnapshot,
+			fmt.Sprintf("topology %q has no deployed nodes", topologySnapshot.Spec.TopologyName),
+		)
+	}
+
+	now := metav1.Now()
+
+	topologySnapshot.Status.Phase = clabernetesapisv1alpha1.TopologySnapshotPhaseRunning
+	topologySnapshot.Status.Message = ""
+	topologySnapshot.Status.StartTime = &now
+	topologySnapshot.Status.ProcessedNodes = 0
+	topologySnapshot.Status.Nodes = make(
+		map[string]clabernetesapisv1alpha1.TopologySnapshotNodeStatus,
+		len(topology.Status.Configs),
+	)
+
+	for launcherName, launcherConfig := range topology.Status.Configs {
+		for nodeName, kind := range nodeKinds(launcherName, launcherConfig) {
+			nodeStatus := clabernetesapisv1alpha1.TopologySnapshotNodeStatus{
+				Phase: clabernetesapisv1alpha1.TopologySnapshotNodePhasePending,
+				Kind:  kind,
+			}
+
+			topologySnapshot.Status.Nodes[nodeName] = nodeStatus
+		}
+	}
+
+	topologySnapshot.Status.TotalNodes = len(topologySnapshot.Status.Nodes)
+
+	c.Recorder.Eventf(
+		topologySnapshot,
+		topology,
+		k8scorev1.EventTypeNormal,
+		clabernetesconstants.EventReasonSnapshotStarted,
+		clabernetesconstants.EventActionSnapshot,
+		"capturing configuration of %d nodes of topology %q",
+		topologySnapshot.Status.TotalNodes,
+		topology.Name,
+	)
+
+	return c.update(ctx, topologySnapshot)
+}
+
+// complete sets the final phase of the snapshot once all nodes have been processed.
+func (c *Controller) complete(
+	ctx context.Context,
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+) error {
+	failedNodes := make([]string, 0)
+
+	for nodeName, nodeStatus := range topologySnapshot.Status.Nodes {
+		if nodeStatus.Phase == clabernetesapisv1alpha1.TopologySnapshotNodePhaseFailed {
+			failedNodes = append(failedNodes, nodeName)
+		}
+	}
+
+	if len(failedNodes) > 0 {
+		sort.Strings(failedNodes)
+
+		return c.fail(
+			ctx,
+			topologySnapshot,
+			fmt.Sprintf(
+				"failed capturing configuration of node(s) %s",
+				strings.Join(failedNodes, ", "),
+			),
+		)
+	}
+
+	now := metav1.Now()
+
+	topologySnapshot.Status.Phase = clabernetesapisv1alpha1.TopologySnapshotPhaseCompleted
+	topologySnapshot.Status.Message = ""
+	topologySnapshot.Status.CompletionTime = &now
+
+	c.Recorder.Eventf(
+		topologySnapshot,
+		nil,
+		k8scorev1.EventTypeNormal,
+		clabernetesconstants.EventReasonSnapshotCompleted,
+		clabernetesconstants.EventActionSnapshot,
+		"captured configuration of %d nodes",
+		topologySnapshot.Status.ProcessedNodes,
+	)
+
+	return c.update(ctx, topologySnapshot)
+}
+
+func (c *Controller) fail(
+	ctx context.Context,
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+	message string,
+) error {
+	now := metav1.Now()
+
+	topologySnapshot.Status.Phase = clabernetesapisv1alpha1.TopologySnapshotPhaseFailed
+	topologySnapshot.Status.Message = message
+	topologySnapshot.Status.CompletionTime = &now
+
+	c.Recorder.Eventf(
+		topologySnapshot,
+		nil,
+		k8scorev1.EventTypeWarning,
+		clabernetesconstants.EventReasonSnapshotFailed,
+		clabernetesconstants.EventActionSnapshot,
+		"%s",
+		message,
+	)
+
+	return c.update(ctx, topologySnapshot)
+}
+
+// getTopology returns the Topology the given TopologySnapshot captures.
+func (c *Controller) getTopology(
+	ctx context.Context,
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+) (*clabernetesapisv1alpha1.Topology, error) {
+	topology := &clabernetesapisv1alpha1.Topology{}
+
+	err := c.Client.Get(
+		ctx,
+		apimachinerytypes.NamespacedName{
+			Namespace: topologySnapshot.Namespace,
+			Name:      topologySnapshot.Spec.TopologyName,
+		},
+		topology,
+	)
+
+	return topology, err
+}
+
+// nextPendingLauncher returns the name of the first (by name) launcher of the given topology
+// configs running any nodes that are still pending in the snapshot, and the (sorted) names of
+// those pending nodes. If there are no launchers with pending nodes left, an empty launcher name
+// is returned.
+func nextPendingLauncher(
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+	configs map[string]string,
+) (string, []string) {
+	for _, launcherName := range slices.Sorted(maps.Keys(configs)) {
+		nodeNames := make([]string, 0)
+
+		for _, nodeName := range slices.Sorted(
+			maps.Keys(nodeKinds(launcherName, configs[launcherName])),
+		) {
+			nodeStatus, ok := topologySnapshot.Status.Nodes[nodeName]
+			if !ok ||
+				nodeStatus.Phase != clabernetesapisv1alpha1.TopologySnapshotNodePhasePending {
+				// not part of the snapshot or already processed (we may be resuming after a
+				// controller restart)
+				continue
+			}
+
+			nodeNames = append(nodeNames, nodeName)
+		}
+
+		if len(nodeNames) > 0 {
+			return launcherName, nodeNames
+		}
+	}
+
+	return "", nil
+}
+
+// failOrphanedNodes marks any nodes that are still pending once all launchers are processed as
+// failed -- this happens when nodes are removed from the topology while the snapshot is running.
+func failOrphanedNodes(topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot) {
+	for nodeName, nodeStatus := range topologySnapshot.Status.Nodes {
+		if nodeStatus.Phase != clabernetesapisv1alpha1.TopologySnapshotNodePhasePending {
+			continue
+		}
+
+		nodeStatus.Phase = clabernetesapisv1alpha1.TopologySnapshotNodePhaseFailed
+		nodeStatus.Message = "node is no longer deployed"
+
+		topologySnapshot.Status.Nodes[nodeName] = nodeStatus
+		topologySnapshot.Status.ProcessedNodes++
+	}
+}
+
+// snapshotLauncher captures the configuration of the given nodes (all running in the launcher
+// with the given name and runtime) and records the result in the node statuses of the snapshot.
+func (c *Controller) snapshotLauncher(
+	ctx context.Context,
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+	launcherName,
+	launcherRuntime string,
+	nodeNames []string,
+) {
+	archive, err := c.execSnapshotCommand(
+		ctx,
+		topologySnapshot,
+		launcherName,
+		launcherRuntime,
+		nodeNames,
+	)
+
+	for _, nodeName := range nodeNames {
+		nodeStatus := topologySnapshot.Status.Nodes[nodeName]
+
+		snapshotErr := err
+
+		if snapshotErr == nil {
+			nodeStatus.ConfigMapName, nodeStatus.Artifacts, snapshotErr = c.storeNodeArtifacts(
+				ctx,
+				topologySnapshot,
+				nodeName,
+				archive,
+			)
+		}
+
+		if snapshotErr != nil {
+			c.Log.Warnf(
+				"failed capturing configuration of node %q for %s '%s/%s', error: %s",
+				nodeName,
+				clabernetesapis.TopologySnapshot,
+				topologySnapshot.Namespace,
+				topologySnapshot.Name,
+				snapshotErr,
+			)
+
+			c.Recorder.Eventf(
+				topologySnapshot,
+				nil,
+				k8scorev1.EventTypeWarning,
+				clabernetesconstants.EventReasonSnapshotNodeFailed,
+				clabernetesconstants.EventActionSnapshot,
+				"failed capturing configuration of node %q, error: %s",
+				nodeName,
+				snapshotErr,
+			)
+
+			nodeStatus.Phase = clabernetesapisv1alpha1.TopologySnapshotNodePhaseFailed
+			nodeStatus.Message = snapshotErr.Error()
+			nodeStatus.ConfigMapName = ""
+			nodeStatus.Artifacts = nil
+		} else {
+			nodeStatus.Phase = clabernetesapisv1alpha1.TopologySnapshotNodePhaseSucceeded
+			nodeStatus.Message = ""
+		}
+
+		topologySnapshot.Status.Nodes[nodeName] = nodeStatus
+		topologySnapshot.Status.ProcessedNodes++
+	}
+}
+
+// execSnapshotCommand executes the SnapshotCommand for the given nodes in the pod of the given
+// launcher and returns the captured archive.
+func (c *Controller) execSnapshotCommand(
+	ctx context.Context,
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+	launcherName,
+	launcherRuntime string,
+	nodeNames []string,
+) ([]byte, error) {
+	launcherPod, err := c.getLauncherPod(ctx, topologySnapshot, launcherName)
+	if err != nil {
+		return nil, err
+	}
+
+	execCtx, cancel := context.WithTimeout(
+		ctx,
+		clabernetesconstants.TopologySnapshotNodeTimeout,
+	)
+	defer cancel()
+
+	stdout, stderr, err := clabernetesutilkubernetes.ExecPodContainer(
+		execCtx,
+		c.Config,
+		c.KubeClient,
+		launcherPod.Namespace,
+		launcherPod.Name,
+		launcherName,
+		SnapshotCommand(launcherName, launcherRuntime, nodeNames),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed executing snapshot command in pod %q, error: %w, stderr: %s",
+			claberneteserrors.ErrReconcile,
+			launcherPod.Name,
+			err,
+			strings.TrimSpace(string(stderr)),
+		)
+	}
+
+	return stdout, nil
+}
+
+// storeNodeArtifacts stores the configuration artifacts of the given node from the captured
+// archive in the node configmap. The name of the configmap and the list of stored artifacts are
+// returned.
+func (c *Controller) storeNodeArtifacts(
+	ctx context.Context,
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+	nodeName string,
+	archive []byte,
+) (string, []clabernetesapisv1alpha1.TopologySnapshotArtifact, error) {
+	configMap, artifacts, err := RenderConfigMap(
+		topologySnapshot,
+		nodeName,
+		archive,
+		clabernetesconfig.GetManager,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = ctrlruntimeutil.SetOwnerReference(topologySnapshot, configMap, c.Client.Scheme())
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = c.createOrUpdateConfigMap(ctx, configMap)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return configMap.Name, artifacts, nil
+}
+
+func (c *Controller) getLauncherPod(
+	ctx context.Context,
+	topologySnapshot *clabernetesapisv1alpha1.TopologySnapshot,
+	launcherName string,
+) (*k8scorev1.Pod, error) {
+	pods := &k8scorev1.PodList{}
+
+	err := c.Client.List(
+		ctx,
+		pods,
+		ctrlruntimeclient.InNamespace(topologySnapshot.Namespace),
+		ctrlruntimeclient.MatchingLabels{
+			clabernetesconstants.LabelTopologyOwner: topologySnapshot.Spec.TopologyName,
+			clabernetesconstants.LabelTopologyNode:  launcherName,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range pods.Items {
+		if pods.Items[idx].DeletionTimestamp != nil {
+			continue
+		}
+
+		if !slices.ContainsFunc(
+			pods.Items[idx].Spec.Containers,
+			func(container k8scorev1.Container) bool { return container.Name == launcherName },
+		) {
+			// not a launcher pod (i.e. an image puller pod for the node)
+			continue
+		}
+
+		if pods.Items[idx].Status.Phase == k8scorev1.PodRunning {
+			return &pods.Items[idx], nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"%w: no running launcher pod found for launcher %q",
+		claberneteserrors.ErrReconcile,
+		launcherName,
+	)
+}
+
+func (c *Controller) createOrUpdateConfigMap(
+	ctx context.Context,
+	renderedConfigMap *k8scorev1.ConfigMap,
+) error {
+	existingConfigMap := &k8scorev1.ConfigMap{}
+
+	err := c.Client.Get(
+		ctx,
+		apimachinerytypes.NamespacedName{
+			Namespace: renderedConfigMap.Namespace,
+			Name:      renderedConfigMap.Name,
+		},
+		existingConfigMap,
+	)
+	if err != nil {
+		if apimachineryerrors.IsNotFound(err) {
+			return c.Client.Create(ctx, renderedConfigMap)
+		}
+
+		return err
+	}
+
+	existingConfigMap.Labels = renderedConfigMap.Labels
+	existingConfigMap.Annotations = renderedConfigMap.Annotations
+	existingConfigMap.OwnerReferences = renderedConfigMap.OwnerReferences
+	existingConfigMap.Data = renderedConfigMap.Data
+	existingConfigMap.BinaryData = renderedConfigMap.BinaryData
+
+	return c.Client.Update(ctx, existingConfigMap)
+}
+
+// nodeKinds returns the containerlab kind of every node in the (sub) topology config of the given
+// launcher -- this is more than one node if the launcher runs a node group. If the config cannot
+// be loaded only the launcher node is returned, with an empty kind.
+func nodeKinds(launcherName, launcherConfig string) map[string]string {
+	config, err := clabernetesutilcontainerlab.LoadContainerlabConfig(launcherConfig)
+	if err != nil || config.Topology == nil || len(config.Topology.Nodes) == 0 {
+		return map[string]string{launcherName: ""}
+	}
+
+	kinds := make(map[string]string, len(config.Topology.Nodes))
+
+	for nodeName := range config.Topology.Nodes {
+		kinds[nodeName], _ = config.Topology.GetNodeKindType(nodeName)
+	}
+
+	return kinds
+}
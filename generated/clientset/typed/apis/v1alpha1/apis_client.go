@@ -32,6 +32,7 @@ type ClabernetesV1alpha1Interface interface {
 	ConnectivitiesGetter
 	ImageRequestsGetter
 	TopologiesGetter
+	TopologySnapshotsGetter
 }
 
 // ClabernetesV1alpha1Client is used to interact with features provided by the clabernetes.containerlab.dev group.
@@ -55,6 +56,10 @@ func (c *ClabernetesV1alpha1Client) Topologies(namespace string) TopologyInterfa
 	return newTopologies(c, namespace)
 }
 
+func (c *ClabernetesV1alpha1Client) TopologySnapshots(namespace string) TopologySnapshotInterface {
+	return newTopologySnapshots(c, namespace)
+}
+
 // NewForConfig creates a new ClabernetesV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
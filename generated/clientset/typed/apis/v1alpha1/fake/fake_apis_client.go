@@ -44,6 +44,10 @@ func (c *FakeClabernetesV1alpha1) Topologies(namespace string) v1alpha1.Topology
 	return newFakeTopologies(c, namespace)
 }
 
+func (c *FakeClabernetesV1alpha1) TopologySnapshots(namespace string) v1alpha1.TopologySnapshotInterface {
+	return newFakeTopologySnapshots(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeClabernetesV1alpha1) RESTClient() rest.Interface {
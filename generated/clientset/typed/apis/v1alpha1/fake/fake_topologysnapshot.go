@@ -0,0 +1,55 @@
+/*
+  Copyright The Kubernetes Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	apisv1alpha1 "github.com/srl-labs/clabernetes/generated/clientset/typed/apis/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeTopologySnapshots implements TopologySnapshotInterface
+type fakeTopologySnapshots struct {
+	*gentype.FakeClientWithList[*v1alpha1.TopologySnapshot, *v1alpha1.TopologySnapshotList]
+	Fake *FakeClabernetesV1alpha1
+}
+
+func newFakeTopologySnapshots(
+	fake *FakeClabernetesV1alpha1,
+	namespace string,
+) apisv1alpha1.TopologySnapshotInterface {
+	return &fakeTopologySnapshots{
+		gentype.NewFakeClientWithList[*v1alpha1.TopologySnapshot, *v1alpha1.TopologySnapshotList](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("topologysnapshots"),
+			v1alpha1.SchemeGroupVersion.WithKind("TopologySnapshot"),
+			func() *v1alpha1.TopologySnapshot { return &v1alpha1.TopologySnapshot{} },
+			func() *v1alpha1.TopologySnapshotList { return &v1alpha1.TopologySnapshotList{} },
+			func(dst, src *v1alpha1.TopologySnapshotList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.TopologySnapshotList) []*v1alpha1.TopologySnapshot {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.TopologySnapshotList, items []*v1alpha1.TopologySnapshot) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -25,3 +25,5 @@ type ConnectivityExpansion interface{}
 type ImageRequestExpansion interface{}
 
 type TopologyExpansion interface{}
+
+type TopologySnapshotExpansion interface{}
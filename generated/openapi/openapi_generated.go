@@ -67,6 +67,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.Deployment": schema_srl_labs_clabernetes_apis_v1alpha1_Deployment(
 			ref,
 		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.ExecProbeConfiguration": schema_srl_labs_clabernetes_apis_v1alpha1_ExecProbeConfiguration(
+			ref,
+		),
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.Expose": schema_srl_labs_clabernetes_apis_v1alpha1_Expose(
 			ref,
 		),
@@ -79,6 +82,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.FileFromURL": schema_srl_labs_clabernetes_apis_v1alpha1_FileFromURL(
 			ref,
 		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.GNMIProbeConfiguration": schema_srl_labs_clabernetes_apis_v1alpha1_GNMIProbeConfiguration(
+			ref,
+		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.HTTPProbeConfiguration": schema_srl_labs_clabernetes_apis_v1alpha1_HTTPProbeConfiguration(
+			ref,
+		),
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.ImagePull": schema_srl_labs_clabernetes_apis_v1alpha1_ImagePull(
 			ref,
 		),
@@ -97,12 +106,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.LinkEndpoint": schema_srl_labs_clabernetes_apis_v1alpha1_LinkEndpoint(
 			ref,
 		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.LinkImpairment": schema_srl_labs_clabernetes_apis_v1alpha1_LinkImpairment(
+			ref,
+		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.NETCONFProbeConfiguration": schema_srl_labs_clabernetes_apis_v1alpha1_NETCONFProbeConfiguration(
+			ref,
+		),
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.Persistence": schema_srl_labs_clabernetes_apis_v1alpha1_Persistence(
 			ref,
 		),
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.PointToPointTunnel": schema_srl_labs_clabernetes_apis_v1alpha1_PointToPointTunnel(
 			ref,
 		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.PointToPointTunnelStatus": schema_srl_labs_clabernetes_apis_v1alpha1_PointToPointTunnelStatus(
+			ref,
+		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.PointToPointTunnelWireGuard": schema_srl_labs_clabernetes_apis_v1alpha1_PointToPointTunnelWireGuard(
+			ref,
+		),
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.ProbeConfiguration": schema_srl_labs_clabernetes_apis_v1alpha1_ProbeConfiguration(
 			ref,
 		),
@@ -124,9 +145,33 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.Topology": schema_srl_labs_clabernetes_apis_v1alpha1_Topology(
 			ref,
 		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyLinkImpairment": schema_srl_labs_clabernetes_apis_v1alpha1_TopologyLinkImpairment(
+			ref,
+		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyLinkState": schema_srl_labs_clabernetes_apis_v1alpha1_TopologyLinkState(
+			ref,
+		),
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyList": schema_srl_labs_clabernetes_apis_v1alpha1_TopologyList(
 			ref,
 		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshot": schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshot(
+			ref,
+		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotArtifact": schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotArtifact(
+			ref,
+		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotList": schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotList(
+			ref,
+		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotNodeStatus": schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotNodeStatus(
+			ref,
+		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotSpec": schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotSpec(
+			ref,
+		),
+		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotStatus": schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotStatus(
+			ref,
+		),
 		"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySpec": schema_srl_labs_clabernetes_apis_v1alpha1_TopologySpec(
 			ref,
 		),
@@ -352,7 +397,7 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_ConfigImagePull(
 					},
 					"criSockOverride": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CRISockOverride allows for overriding the path of the CRI sock that is mounted in the launcher pods (if/when image pull through mode is auto or always). This can be useful if, for example, the CRI sock is in a \"non-standard\" location like K3s which puts the containerd sock at `/run/k3s/containerd/containerd.sock` rather than the \"normal\" (whatever that means) location of `/run/containerd/containerd.sock`. The value must end with \"containerd.sock\" for now, in the future maybe crio support will be added.",
+							Description: "CRISockOverride allows for overriding the path of the CRI sock that is mounted in the launcher pods (if/when image pull through mode is auto or always). This can be useful if, for example, the CRI sock is in a \"non-standard\" location like K3s which puts the containerd sock at `/run/k3s/containerd/containerd.sock` rather than the \"normal\" (whatever that means) location of `/run/containerd/containerd.sock`. The value must end with \"containerd.sock\" or \"docker.sock\" -- or, for crio, it must be the containers/storage directory (ending with \"storage\", typically `/var/lib/containers/storage`) as crio images are read directly from the node's image storage.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -378,6 +423,20 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_ConfigImagePull(
 							Format:      "",
 						},
 					},
+					"imageCacheHostPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageCacheHostPath enables the node image cache when set -- this is the path on the kubernetes nodes that launchers use to share node images exported from the CRI (if/when image pull through mode is auto or always). Rather than each launcher exporting its own copy of an image, the first launcher on a kubernetes node exports it to the cache (keyed by image id) and any other launchers on that node reuse it. Cached images unused for seven days are evicted. For example: `/var/lib/clabernetes/image-cache`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prewarm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prewarm sets the global default for pre-pulling (\"prewarming\") topology images onto the kubernetes nodes the launchers could be scheduled on before the launchers are deployed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -711,8 +770,36 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_ConnectivityStatus(
 			SchemaProps: spec.SchemaProps{
 				Description: "ConnectivityStatus is the status for a Connectivity resource.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pointToPointTunnels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PointToPointTunnels holds the observed state of the point-to-point tunnels as reported by the launchers. The mapping is nodeName (i.e. srl1) -> p2p tunnel status.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: map[string]interface{}{},
+													Ref: ref(
+														"github.com/srl-labs/clabernetes/apis/v1alpha1.PointToPointTunnelStatus",
+													),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.PointToPointTunnelStatus"},
 	}
 }
 
@@ -778,6 +865,29 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_Deployment(
 							),
 						},
 					},
+					"nodeGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeGroups is a mapping of node name to a list of (other) node names that should be co-located with that node -- that is, deployed in the same launcher pod. The nodes of a group are rendered into a single sub-topology (and a single launcher Deployment named after the \"primary\" node, the key of the mapping); links between the nodes of a group stay native veth links, only links to nodes outside the group become tunnels. This is useful for traffic-heavy node pairs such as a traffic generator and its DUT. Only the primary node of a group is exposed, a node may only be part of one group, and bridge nodes cannot be grouped. Nodes that share a network namespace (network-mode \"container:<primary>\") are always co-located with their primary and do not need to be listed here. Only applies to containerlab topologies.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 					"privilegedLauncher": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PrivilegedLauncher, when true, sets the launcher containers to privileged. Historically we tried very hard to *not* need to set privileged mode on pods, however the reality is it is much, much easier to get various network operating system images booting with this enabled, so, the default mode is to set the privileged flag on pods. Disabling this option causes clabernetes to try to run the pods for this topology in the \"not so privileged\" mode -- this basically means we mount all capabilities we think should be available, set apparmor to \"unconfined\", and mount paths like /dev/kvm and dev/net/tun. With this \"not so privileged\" mode, Nokia SRL devices and Arista cEOS devices have been able to boot on some clusters, but your mileage may vary. In short: if you don't care about having some privileged pods, just leave this alone.",
@@ -833,6 +943,13 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_Deployment(
 							},
 						},
 					},
+					"restoreFromSnapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestoreFromSnapshot is the name of a (completed) TopologySnapshot in the namespace of the topology to restore the node configurations from. When set, the configuration captured for each node in the snapshot is mounted in the launcher and used as the (enforced) startup-config of the node, and the launchers of the affected nodes are restarted. Changing the snapshot restores the nodes to that snapshot, clearing it restarts the nodes with their original startup-config. Nodes are matched by name, nodes that were not captured in the snapshot are left untouched.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"persistence": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Persistence holds configurations relating to persisting each nodes working containerlab directory.",
@@ -885,6 +1002,13 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_Deployment(
 							Format:      "",
 						},
 					},
+					"launcherRuntime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LauncherRuntime sets the container runtime the launcher uses to run the containerlab sub-topology. The default, \"docker\", runs a nested docker daemon in each launcher. \"podman\" instead uses containerlab's podman runtime so no docker daemon is started at all; this avoids docker-in-docker but note that the launcher still needs enough privileges to create the node containers and their network interfaces.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"extraEnv": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -912,6 +1036,49 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_Deployment(
 	}
 }
 
+func schema_srl_labs_clabernetes_apis_v1alpha1_ExecProbeConfiguration(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExecProbeConfiguration defines an \"exec\" probe -- the probe executes a command in the node container and is successful if the command exits successfully and (if set) its output matches the expected output. The probe is executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the command (and its arguments) to execute in the node container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"expectedOutput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpectedOutput is a regular expression the (combined stdout and stderr) output of the command must match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"command"},
+			},
+		},
+	}
+}
+
 func schema_srl_labs_clabernetes_apis_v1alpha1_Expose(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
@@ -939,7 +1106,7 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_Expose(
 					},
 					"exposeType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExposeType configures the service type(s) related to exposing the topology. This is an enum that has the following valid values: - None: expose is *not* disabled, but we just don't create any services related to the pods,\n        you may want to do this if you want to tickle the pods by pod name directly for some\n        reason while not having extra services floating around.\n- ClusterIP: a clusterip service is created so you can hit that service name for the pods. - LoadBalancer: (default) creates a load balancer service so you can access your pods from\n        outside the cluster. this is/was the only behavior up to v0.2.4.",
+							Description: "ExposeType configures the service type(s) related to exposing the topology. This is an enum that has the following valid values: - None: expose is *not* disabled, but we just don't create any services related to the pods,\n        you may want to do this if you want to tickle the pods by pod name directly for some\n        reason while not having extra services floating around.\n- ClusterIP: a clusterip service is created so you can hit that service name for the pods. - Headless: a headless service (clusterIP: None) is created. This is useful when you don't\n        need load-balancing or a single service IP but want to directly connect to pods via\n        DNS records that return pod IPs.\n- LoadBalancer: (default) creates a load balancer service so you can access your pods from\n        outside the cluster. this is/was the only behavior up to v0.2.4.\n- SharedLoadBalancer: creates a single load balancer service for the whole topology, each\n        exposed node port is mapped to a distinct port on that load balancer -- the mapping\n        is reported in the topology status exposed ports. Nodes also get a clusterip service.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1021,6 +1188,22 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_ExposedPorts(
 							},
 						},
 					},
+					"externalPorts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalPorts maps the exposed ports of the node, as \"<port>/<protocol>\" (i.e. \"22/tcp\"), to the port they are reachable on at the load balancer address. This is only set when using the SharedLoadBalancer expose type, as all nodes then share one load balancer address.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"loadBalancerAddress", "tcpPorts", "udpPorts"},
 			},
@@ -1107,6 +1290,103 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_FileFromURL(
 	}
 }
 
+func schema_srl_labs_clabernetes_apis_v1alpha1_GNMIProbeConfiguration(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GNMIProbeConfiguration defines a \"gnmi\" probe -- the probe sends a gNMI capabilities request to the node and is successful if the node answers it. Unless Insecure is set the connection uses TLS (without verifying the certificate of the node). The probe is executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port of the gNMI server.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the username to use for auth, if unset (and not set in CredentialsSecret) no credentials are sent.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password is the password to use for auth. Note that this ends up in plain text in the launcher Deployment, prefer CredentialsSecret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecret is the name of a Secret in the namespace of the Topology holding the credentials to use for auth. The \"username\" and \"password\" keys (as used by the kubernetes.io/basic-auth Secret type) are used if present, the username and password set inline take precedence over the ones in the Secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Insecure disables TLS, meaning the probe uses a plaintext connection.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"port"},
+			},
+		},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_HTTPProbeConfiguration(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPProbeConfiguration defines a \"http\" probe -- the probe sends a GET request to the node and is successful if the response has the expected status code. Certificates are not verified when using HTTPS, redirects are not followed. The probe is executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port to send the request to.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path to request, defaults to \"/\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scheme": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scheme is the scheme to use for the request, defaults to \"HTTP\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expectedStatusCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpectedStatusCode is the status code the response must have, if unset any 2xx or 3xx status code is considered successful.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"port"},
+			},
+		},
+	}
+}
+
 func schema_srl_labs_clabernetes_apis_v1alpha1_ImagePull(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
@@ -1172,6 +1452,13 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_ImagePull(
 							Format:      "",
 						},
 					},
+					"prewarm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prewarm enables pre-pulling (\"prewarming\") the images of this topology. When enabled, the controller requests every image of the topology to be pulled onto all kubernetes nodes that the launchers using that image could be scheduled on, before the launchers are deployed. Pre-pulling is done via the image pull through machinery, so it has no effect if image pull through mode is never. If unset, the global config value is used.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1382,34 +1669,90 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_ImageRequestStatus(
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"accepted", "complete"},
-			},
-		},
-	}
-}
-
-func schema_srl_labs_clabernetes_apis_v1alpha1_LinkEndpoint(
-	ref common.ReferenceCallback,
-) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LinkEndpoint is a simple struct to hold node/interface name info for a given link.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"nodeName": {
+					"attempts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeName is the name of the node this link resides on.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Attempts is the number of times the ImageRequest controller has attempted to pull the image (spawned a puller pod) for this image request.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"interfaceName": {
+					"failureReason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InterfaceName is the name of the interface on the node this link is on.",
-							Default:     "",
+							Description: "FailureReason is the reason the last pull attempt failed as reported by the kubelet for the puller pod container, for example \"ErrImagePull\" or \"InvalidImageName\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureMessage is the message accompanying the failure reason, this usually holds the actual registry error -- for example the image not being found or the registry refusing our credentials.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nextAttemptTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextAttemptTime is the time of the next pull attempt after a failed attempt that is going to be retried, the ImageRequest controller backs off until then.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time the image request was completed, either because the image was pulled or because all pull attempts failed. The image request is deleted once its time to live has passed since this time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions is a list of conditions for the image request, the \"Pulled\" condition reflects the state of the image pull on the requested node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref: ref(
+											"k8s.io/apimachinery/pkg/apis/meta/v1.Condition",
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"accepted", "complete"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_LinkEndpoint(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LinkEndpoint is a simple struct to hold node/interface name info for a given link.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeName is the name of the node this link resides on.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interfaceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfaceName is the name of the interface on the node this link is on.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1421,6 +1764,95 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_LinkEndpoint(
 	}
 }
 
+func schema_srl_labs_clabernetes_apis_v1alpha1_LinkImpairment(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LinkImpairment holds netem style impairments to apply to the traffic a node sends out of an interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"delay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delay is the delay (latency) to add to packets, as a go duration string, for example \"50ms\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jitter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jitter is the variation of the delay, as a go duration string, for example \"5ms\". Jitter is only applied if a delay is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"loss": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Loss is the percentage of packets to drop, for example \"0.5\" to drop 0.5% of packets.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate is the rate limit in kbit/s, unset (or zero) means no rate limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_NETCONFProbeConfiguration(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NETCONFProbeConfiguration defines a \"netconf\" probe -- the probe opens the netconf ssh subsystem of the node and is successful if the node sends its hello message. The probe is executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the username to use for auth, required unless CredentialsSecret is set.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password is the password to use for auth, required unless CredentialsSecret is set. Note that this ends up in plain text in the launcher Deployment, prefer CredentialsSecret.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecret is the name of a Secret in the namespace of the Topology holding the credentials to use for auth. The \"username\" and \"password\" keys (as used by the kubernetes.io/basic-auth Secret type) are used if present, the username and password set inline take precedence over the ones in the Secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is an optional override (of course default is 830).",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_srl_labs_clabernetes_apis_v1alpha1_Persistence(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
@@ -1452,6 +1884,13 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_Persistence(
 							Format:      "",
 						},
 					},
+					"saveOnTeardown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SaveOnTeardown, when true, causes each launcher to run \"containerlab save\" (saving the running configuration of the node into the persisted lab directory) before destroying the lab when the Topology is deleted. This has no effect if persistence is not enabled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"enabled"},
 			},
@@ -1465,7 +1904,7 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_PointToPointTunnel(
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PointToPointTunnel holds information necessary for creating a tunnel between two interfaces on different nodes of a clabernetes Topology. This connection can be established by using clab tools (vxlan) or the experimental slurpeeth (tcp tunnel magic).",
+				Description: "PointToPointTunnel holds information necessary for creating a tunnel between two interfaces on different nodes of a clabernetes Topology. This connection can be established by using clab tools (vxlan), netlink (vxlan, geneve or vxlan inside of wireguard) or the experimental slurpeeth (tcp tunnel magic).",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"tunnelID": {
@@ -1516,6 +1955,29 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_PointToPointTunnel(
 							Format:      "",
 						},
 					},
+					"impairment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Impairment holds the impairments to apply to the local interface of this tunnel, if any.",
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.LinkImpairment",
+							),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the desired administrative state of the local interface of this tunnel, either \"up\" or \"down\". An empty state means \"up\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wireGuard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireGuard holds the wireguard peering information of this tunnel, only set when the topology uses \"wireguard\" connectivity.",
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.PointToPointTunnelWireGuard",
+							),
+						},
+					},
 				},
 				Required: []string{
 					"tunnelID",
@@ -1527,384 +1989,1003 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_PointToPointTunnel(
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.LinkImpairment", "github.com/srl-labs/clabernetes/apis/v1alpha1.PointToPointTunnelWireGuard"},
 	}
 }
 
-func schema_srl_labs_clabernetes_apis_v1alpha1_ProbeConfiguration(
+func schema_srl_labs_clabernetes_apis_v1alpha1_PointToPointTunnelStatus(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProbeConfiguration holds information about how to probe a (containerlab) node in a Topology. If both style probes are configured, both will be used and both must succeed in order to report healthy.",
+				Description: "PointToPointTunnelStatus holds the observed state of one side of a point-to-point tunnel as reported by the launcher of the local node.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"startupSeconds": {
+					"tunnelID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "StartupSeconds is the total amount of seconds to allow for the node to start. This defaults to ~13 minutes to hopefully account for slow to boot nodes. Note that there is also a 60 initial delay configured, so technically the default is ~14-15 minutes. Be careful with this delay as there must be time for c9s to (via whatever means) pull the image and load it into docker on the launcher and this can take a bit! Having this be bigger than you think you need is generally better since if the startup probe succeeds ever then the readiness probe takes over anyway.",
+							Description: "TunnelID is the id number of the tunnel (vnid or segment id).",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"sshProbeConfiguration": {
+					"localNode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHProbeConfiguration defines an SSH probe.",
-							Ref: ref(
-								"github.com/srl-labs/clabernetes/apis/v1alpha1.SSHProbeConfiguration",
-							),
+							Description: "LocalNode is the local node (in the clabernetes topology) of this tunnel.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tcpProbeConfiguration": {
+					"localInterface": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TCPProbeConfiguration defines a TCP probe.",
-							Ref: ref(
-								"github.com/srl-labs/clabernetes/apis/v1alpha1.TCPProbeConfiguration",
-							),
+							Description: "LocalInterface is the local termination of this tunnel.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the administrative state, either \"up\" or \"down\", last applied to the local interface of this tunnel.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interfaceExists": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfaceExists indicates if the (launcher side) local interface of this tunnel exists.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"operState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperState is the operational state of the local interface of this tunnel as reported by the kernel, for example \"up\", \"down\" or \"unknown\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RxBytes is the number of bytes received on the local interface of this tunnel.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rxPackets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RxPackets is the number of packets received on the local interface of this tunnel.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"txBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TxBytes is the number of bytes transmitted on the local interface of this tunnel.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"txPackets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TxPackets is the number of packets transmitted on the local interface of this tunnel.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time the launcher last reported the state of this tunnel.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"tunnelID", "localInterface", "state", "interfaceExists"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/srl-labs/clabernetes/apis/v1alpha1.SSHProbeConfiguration", "github.com/srl-labs/clabernetes/apis/v1alpha1.TCPProbeConfiguration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_srl_labs_clabernetes_apis_v1alpha1_ReconcileHashes(
+func schema_srl_labs_clabernetes_apis_v1alpha1_PointToPointTunnelWireGuard(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReconcileHashes holds hashes of the last recorded reconciliation -- these are used to know if things have changed between the last and current reconciliation.",
+				Description: "PointToPointTunnelWireGuard holds the information necessary to carry a tunnel through the (encrypted) wireguard interfaces of the local and remote launchers.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"config": {
+					"localAddress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Config is the last stored hash of the rendered config(s) -- that is, the map of \"sub topologies\" representing the overall Topology.Spec.Definition.",
+							Description: "LocalAddress is the address of the wireguard interface of the local launcher.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"exposedPorts": {
+					"remoteAddress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExposedPorts is the last stored hash of the exposed ports mapping for this Topology. Note that while we obviously care about the exposed ports on a *per node basis*, we don't need to track that here -- this is here strictly to track differences in the load balancer service -- the actual sub-topologies (or sub-configs) effectively track the expose port status per node.",
+							Description: "RemoteAddress is the address of the wireguard interface of the remote launcher, this is the address the tunnel is terminated on in the remote launcher.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remotePublicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemotePublicKey is the (base64 encoded) wireguard public key of the remote launcher.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"filesFromURL": {
+				},
+				Required: []string{"localAddress", "remoteAddress", "remotePublicKey"},
+			},
+		},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_ProbeConfiguration(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProbeConfiguration holds information about how to probe a (containerlab) node in a Topology. If multiple probes are configured, all of them will be used and all must succeed in order to report healthy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startupSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupSeconds is the total amount of seconds to allow for the node to start. This defaults to ~13 minutes to hopefully account for slow to boot nodes. Note that there is also a 60 initial delay configured, so technically the default is ~14-15 minutes. Be careful with this delay as there must be time for c9s to (via whatever means) pull the image and load it into docker on the launcher and this can take a bit! Having this be bigger than you think you need is generally better since if the startup probe succeeds ever then the readiness probe takes over anyway.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"initialDelaySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialDelaySeconds is the delay before the startup probe starts checking the node status, defaults to 60 seconds. Lightweight nodes that are ready within seconds may want to set this to a (much) lower value.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"intervalSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IntervalSeconds is the interval at which the launcher probes the node and at which the startup/readiness (and if enabled liveness) probes check the result, defaults to 20 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the timeout for the launcher probe attempts, defaults to 5 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readinessFailureThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadinessFailureThreshold is the number of consecutive failed checks after which the node is reported as not ready, defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"livenessFailureThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LivenessFailureThreshold is the number of consecutive failed checks (after the node has started) after which the node is considered dead and the LivenessRestartPolicy is applied. Unset (or zero) means there is no liveness probe and the node is never restarted due to failing checks.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"livenessRestartPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LivenessRestartPolicy is what happens when the liveness probe fails, it only has an effect if LivenessFailureThreshold is set. \"never\" only reports the liveness status, \"node\" redeploys the containerlab node(s) of the launcher (and re-applies their tunnels) and \"launcher\" (the default) restarts the launcher (and so the node along with it) via a k8s liveness probe.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sshProbeConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHProbeConfiguration defines an SSH probe.",
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.SSHProbeConfiguration",
+							),
+						},
+					},
+					"tcpProbeConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TCPProbeConfiguration defines a TCP probe.",
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.TCPProbeConfiguration",
+							),
+						},
+					},
+					"httpProbeConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTPProbeConfiguration defines an HTTP(S) probe.",
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.HTTPProbeConfiguration",
+							),
+						},
+					},
+					"gnmiProbeConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GNMIProbeConfiguration defines a gNMI probe.",
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.GNMIProbeConfiguration",
+							),
+						},
+					},
+					"netconfProbeConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NETCONFProbeConfiguration defines a NETCONF probe.",
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.NETCONFProbeConfiguration",
+							),
+						},
+					},
+					"execProbeConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecProbeConfiguration defines an exec probe.",
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.ExecProbeConfiguration",
+							),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.ExecProbeConfiguration", "github.com/srl-labs/clabernetes/apis/v1alpha1.GNMIProbeConfiguration", "github.com/srl-labs/clabernetes/apis/v1alpha1.HTTPProbeConfiguration", "github.com/srl-labs/clabernetes/apis/v1alpha1.NETCONFProbeConfiguration", "github.com/srl-labs/clabernetes/apis/v1alpha1.SSHProbeConfiguration", "github.com/srl-labs/clabernetes/apis/v1alpha1.TCPProbeConfiguration"},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_ReconcileHashes(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReconcileHashes holds hashes of the last recorded reconciliation -- these are used to know if things have changed between the last and current reconciliation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config is the last stored hash of the rendered config(s) -- that is, the map of \"sub topologies\" representing the overall Topology.Spec.Definition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exposedPorts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExposedPorts is the last stored hash of the exposed ports mapping for this Topology. Note that while we obviously care about the exposed ports on a *per node basis*, we don't need to track that here -- this is here strictly to track differences in the load balancer service -- the actual sub-topologies (or sub-configs) effectively track the expose port status per node.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filesFromURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FilesFromURL is the hash of the last stored mapping of files from URL (to node mapping). Note that this is tracked on a *per node basis* because the URL of a file could be updated without any change to the actual config/topology (or sub-config/sub-topology); as such we need to explicitly track this per node to know when a node needs to be restarted such that the new URL is \"picked up\" by the node/launcher.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"imagePullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecrets is the hash of hte last stored image pull secrets for this Topology.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"config", "exposedPorts", "filesFromURL", "imagePullSecrets"},
+			},
+		},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_SSHProbeConfiguration(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHProbeConfiguration defines a \"ssh\" probe -- the ssh probe just connects using standard go crypto ssh setup and reports true if auth (password or public key) is successful, it does no further checking. The probe is executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status. Credentials can be set inline (Username/Password) or, so they do not show up in the launcher Deployment, be sourced from a Secret via CredentialsSecret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the username to use for auth, required unless CredentialsSecret is set.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password is the password to use for auth, required unless CredentialsSecret is set. Note that this ends up in plain text in the launcher Deployment, prefer CredentialsSecret.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecret is the name of a Secret in the namespace of the Topology holding the credentials to use for auth. The \"username\", \"password\" and \"ssh-privatekey\" keys (as used by the kubernetes.io/basic-auth and kubernetes.io/ssh-auth Secret types) are used if present, the username and password set inline take precedence over the ones in the Secret. The values are injected into the launcher via environment variable references and so never show up in the launcher Deployment itself.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is an optional override (of course default is 22).",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_Scheduling(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Scheduling holds information about how the launcher pod(s) should be configured with respect to \"scheduling\" things (affinity/node selector/tolerations).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector sets the node selector that will be configured on all launcher pods for this Topology.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations is a list of Tolerations that will be set on the launcher pod spec.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_StatusProbes(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StatusProbes holds details about if the status probes are enabled and if so how they should be handled.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled sets the status probes to enabled (or obviously disabled). Note that if the probes are enabled and the health condition fails due to configuring the node the cluster will restart the node. So, if you plan on being destructive with the node config (probably because you will have exec'd onto the node) then you may want to disable this!",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"excludedNodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludedNodes is a set of nodes to be excluded from status probe checking. It may be desirable to exclude some node(s) from status checking due to them not having an easy way for clabernetes to check the state of the node. The node names here should match the name of the nodes in the containerlab sub-topology.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"nodeProbeConfigurations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeProbeConfigurations is a map of node specific probe configurations -- if you only need a simple ssh or tcp connect style setup that works on all node types in the topology you can ignore this and just configure ProbeConfiguration.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref: ref(
+											"github.com/srl-labs/clabernetes/apis/v1alpha1.ProbeConfiguration",
+										),
+									},
+								},
+							},
+						},
+					},
+					"probeConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProbeConfiguration is the default probe configuration for the Topology.",
+							Default:     map[string]interface{}{},
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.ProbeConfiguration",
+							),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.ProbeConfiguration"},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_TCPProbeConfiguration(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TCPProbeConfiguration defines a \"tcp\" probe. The probe is executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port defines the port to try to open a TCP connection to. When using TCP probe setup this connection happens inside the launcher rather than the \"normal\" k8s style probes. This style probe behaves like a k8s style probe though in that it is \"successful\" whenever a TCP connection to this port can be opened successfully.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"port"},
+			},
+		},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_Topology(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Topology is an object that holds information about a clabernetes Topology -- that is, a valid topology file (ex: containerlab topology), and any associated configurations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySpec",
+							),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyStatus",
+							),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySpec", "github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_TopologyLinkImpairment(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TopologyLinkImpairment associates a LinkImpairment with a link of the topology definition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoints are the two endpoints (\"node:interface\") of the link to impair, exactly as in the topology definition. The order of the endpoints does not matter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"impairment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Impairment is the impairment to apply to both directions of the link.",
+							Default:     map[string]interface{}{},
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.LinkImpairment",
+							),
+						},
+					},
+				},
+				Required: []string{"endpoints", "impairment"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.LinkImpairment"},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_TopologyLinkState(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TopologyLinkState associates an administrative state with a link of the topology definition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoints are the two endpoints (\"node:interface\") of the link, exactly as in the topology definition. The order of the endpoints does not matter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the desired administrative state of the link, either \"up\" or \"down\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"endpoints", "state"},
+			},
+		},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_TopologyList(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TopologyList is a list of Topology objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref: ref(
+											"github.com/srl-labs/clabernetes/apis/v1alpha1.Topology",
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.Topology", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshot(
+	ref common.ReferenceCallback,
+) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TopologySnapshot is an object that represents a request to capture (\"containerlab save\") the running configuration of every node in a Topology. The captured configuration artifacts are stored in a ConfigMap per node, owned by the TopologySnapshot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FilesFromURL is the hash of the last stored mapping of files from URL (to node mapping). Note that this is tracked on a *per node basis* because the URL of a file could be updated without any change to the actual config/topology (or sub-config/sub-topology); as such we need to explicitly track this per node to know when a node needs to be restarted such that the new URL is \"picked up\" by the node/launcher.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotSpec",
+							),
 						},
 					},
-					"imagePullSecrets": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImagePullSecrets is the hash of hte last stored image pull secrets for this Topology.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref: ref(
+								"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotStatus",
+							),
 						},
 					},
 				},
-				Required: []string{"config", "exposedPorts", "filesFromURL", "imagePullSecrets"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotSpec", "github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_srl_labs_clabernetes_apis_v1alpha1_SSHProbeConfiguration(
+func schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotArtifact(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SSHProbeConfiguration defines a \"ssh\" probe -- the ssh probe just connects using standard go crypto ssh setup and reports true if auth is successful, it does no further checking. The probe is executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status.",
+				Description: "TopologySnapshotArtifact is a single file captured during the snapshot of a node.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"username": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username is the username to use for auth.",
+							Description: "Path is the path of the file relative to the node directory in the containerlab lab directory.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"password": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Password is the password to use for auth.",
+							Description: "Key is the key in the node ConfigMap that holds the contents of the file.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"port": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Port is an optional override (of course default is 22).",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
 				},
-				Required: []string{"username", "password"},
+				Required: []string{"path", "key"},
 			},
 		},
 	}
 }
 
-func schema_srl_labs_clabernetes_apis_v1alpha1_Scheduling(
+func schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotList(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Scheduling holds information about how the launcher pod(s) should be configured with respect to \"scheduling\" things (affinity/node selector/tolerations).",
+				Description: "TopologySnapshotList is a list of TopologySnapshot objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodeSelector": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector sets the node selector that will be configured on all launcher pods for this Topology.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tolerations": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tolerations is a list of Tolerations that will be set on the launcher pod spec.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+										Ref: ref(
+											"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshot",
+										),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Toleration"},
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_srl_labs_clabernetes_apis_v1alpha1_StatusProbes(
+func schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotNodeStatus(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StatusProbes holds details about if the status probes are enabled and if so how they should be handled.",
+				Description: "TopologySnapshotNodeStatus is the snapshot status of a single node in a TopologySnapshot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled sets the status probes to enabled (or obviously disabled). Note that if the probes are enabled and the health condition fails due to configuring the node the cluster will restart the node. So, if you plan on being destructive with the node config (probably because you will have exec'd onto the node) then you may want to disable this!",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Phase is the phase of the snapshot of this node.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"excludedNodes": {
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the containerlab kind of the node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message holds a human-readable message about the snapshot of this node, for example why the snapshot of the node failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapName is the name of the ConfigMap the node configuration artifacts are stored in.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"artifacts": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "ExcludedNodes is a set of nodes to be excluded from status probe checking. It may be desirable to exclude some node(s) from status checking due to them not having an easy way for clabernetes to check the state of the node. The node names here should match the name of the nodes in the containerlab sub-topology.",
+							Description: "Artifacts is the list of configuration artifacts captured for this node.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"nodeProbeConfigurations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NodeProbeConfigurations is a map of node specific probe configurations -- if you only need a simple ssh or tcp connect style setup that works on all node types in the topology you can ignore this and just configure ProbeConfiguration.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
 										Ref: ref(
-											"github.com/srl-labs/clabernetes/apis/v1alpha1.ProbeConfiguration",
+											"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotArtifact",
 										),
 									},
 								},
 							},
 						},
 					},
-					"probeConfiguration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProbeConfiguration is the default probe configuration for the Topology.",
-							Default:     map[string]interface{}{},
-							Ref: ref(
-								"github.com/srl-labs/clabernetes/apis/v1alpha1.ProbeConfiguration",
-							),
-						},
-					},
 				},
+				Required: []string{"phase"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/srl-labs/clabernetes/apis/v1alpha1.ProbeConfiguration"},
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotArtifact"},
 	}
 }
 
-func schema_srl_labs_clabernetes_apis_v1alpha1_TCPProbeConfiguration(
+func schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotSpec(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TCPProbeConfiguration defines a \"tcp\" probe. The probe is executed by the launcher and the result is placed into /clabernetes/.nodestatus so the k8s probe can pick it up and reflect the status.",
+				Description: "TopologySnapshotSpec is the spec for a TopologySnapshot resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"port": {
+					"topologyName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Port defines the port to try to open a TCP connection to. When using TCP probe setup this connection happens inside the launcher rather than the \"normal\" k8s style probes. This style probe behaves like a k8s style probe though in that it is \"successful\" whenever a TCP connection to this port can be opened successfully.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "TopologyName is the name of the Topology (in the same namespace as the TopologySnapshot) to capture the node configurations of.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"port"},
+				Required: []string{"topologyName"},
 			},
 		},
 	}
 }
 
-func schema_srl_labs_clabernetes_apis_v1alpha1_Topology(
+func schema_srl_labs_clabernetes_apis_v1alpha1_TopologySnapshotStatus(
 	ref common.ReferenceCallback,
 ) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Topology is an object that holds information about a clabernetes Topology -- that is, a valid topology file (ex: containerlab topology), and any associated configurations.",
+				Description: "TopologySnapshotStatus is the status for a TopologySnapshot resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Phase is the overall phase of the snapshot.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Message holds a human-readable message about the phase of the snapshot, for example why the snapshot failed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref: ref(
-								"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySpec",
-							),
-						},
-					},
-					"status": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref: ref(
-								"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyStatus",
-							),
+							Description: "StartTime is the time the snapshot was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySpec", "github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_srl_labs_clabernetes_apis_v1alpha1_TopologyList(
-	ref common.ReferenceCallback,
-) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TopologyList is a list of Topology objects.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "CompletionTime is the time the snapshot finished (successfully or not).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"apiVersion": {
+					"totalNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TotalNodes is the number of nodes in the topology being snapshotted.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+					"processedNodes": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ProcessedNodes is the number of nodes that have been snapshotted so far, whether successfully or not.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"items": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Nodes is a map of node name to the snapshot status of that node.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
 										Ref: ref(
-											"github.com/srl-labs/clabernetes/apis/v1alpha1.Topology",
+											"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotNodeStatus",
 										),
 									},
 								},
@@ -1912,11 +2993,10 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_TopologyList(
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/srl-labs/clabernetes/apis/v1alpha1.Topology", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologySnapshotNodeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1984,17 +3064,59 @@ func schema_srl_labs_clabernetes_apis_v1alpha1_TopologySpec(
 					},
 					"connectivity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Connectivity defines the type of connectivity to use between nodes in the topology. The default behavior is to use vxlan tunnels, alternatively you can enable a more experimental \"slurpeeth\" connectivity flavor that stuffs traffic into tcp tunnels to avoid any vxlan mtu and/or fragmentation challenges.",
+							Description: "Connectivity defines the type of connectivity to use between nodes in the topology. The default behavior is to use vxlan tunnels, alternatively you can enable a more experimental \"slurpeeth\" connectivity flavor that stuffs traffic into tcp tunnels to avoid any vxlan mtu and/or fragmentation challenges, \"vxlan-netlink\" which sets up the same vxlan tunnels as \"vxlan\" but natively via netlink rather than by shelling out to containerlab, \"geneve\" which sets up geneve tunnels (via netlink), or \"wireguard\" which carries vxlan tunnels through encrypted wireguard interfaces between the launchers (keys are generated per topology and stored in a secret).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"linkImpairments": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LinkImpairments is a list of netem style impairments (delay, jitter, loss, rate) to apply to links of the topology. Impairments are applied by the launchers on both sides of a link (to the traffic each node sends over the link) and are updated live when changed, the launchers are not restarted. Only links between nodes in different launchers can be impaired, links between nodes in the same launcher (or to the host) are left untouched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref: ref(
+											"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyLinkImpairment",
+										),
+									},
+								},
+							},
+						},
+					},
+					"linkStates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LinkStates is a list of administrative state overrides for links of the topology, setting a link \"down\" sets the interfaces of both sides of the link down without redeploying anything, removing the override (or setting it \"up\") brings the link back up. Like impairments, only links between nodes in different launchers can be set down.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref: ref(
+											"github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyLinkState",
+										),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"definition", "naming"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/srl-labs/clabernetes/apis/v1alpha1.Definition", "github.com/srl-labs/clabernetes/apis/v1alpha1.Deployment", "github.com/srl-labs/clabernetes/apis/v1alpha1.Expose", "github.com/srl-labs/clabernetes/apis/v1alpha1.ImagePull", "github.com/srl-labs/clabernetes/apis/v1alpha1.StatusProbes"},
+			"github.com/srl-labs/clabernetes/apis/v1alpha1.Definition", "github.com/srl-labs/clabernetes/apis/v1alpha1.Deployment", "github.com/srl-labs/clabernetes/apis/v1alpha1.Expose", "github.com/srl-labs/clabernetes/apis/v1alpha1.ImagePull", "github.com/srl-labs/clabernetes/apis/v1alpha1.StatusProbes", "github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyLinkImpairment", "github.com/srl-labs/clabernetes/apis/v1alpha1.TopologyLinkState"},
 	}
 }
 
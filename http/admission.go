@@ -0,0 +1,79 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	k8sadmissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	maxAdmissionReviewBytes = 3 * 1024 * 1024
+)
+
+func readAdmissionReview(r *http.Request) (*k8sadmissionv1.AdmissionReview, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxAdmissionReviewBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	review := &k8sadmissionv1.AdmissionReview{}
+
+	err = json.Unmarshal(body, review)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.Request == nil {
+		return nil, fmt.Errorf(
+			"%w: admission review contains no request",
+			claberneteserrors.ErrInvalidData,
+		)
+	}
+
+	return review, nil
+}
+
+func writeAdmissionReview(
+	w http.ResponseWriter,
+	review *k8sadmissionv1.AdmissionReview,
+	response *k8sadmissionv1.AdmissionResponse,
+) error {
+	response.UID = review.Request.UID
+
+	out, err := json.Marshal(&k8sadmissionv1.AdmissionReview{
+		TypeMeta: review.TypeMeta,
+		Response: response,
+	})
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	_, err = w.Write(out)
+
+	return err
+}
+
+func allowedAdmissionResponse() *k8sadmissionv1.AdmissionResponse {
+	return &k8sadmissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+}
+
+func deniedAdmissionResponse(err error) *k8sadmissionv1.AdmissionResponse {
+	return &k8sadmissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: err.Error(),
+			Reason:  metav1.StatusReasonInvalid,
+			Code:    http.StatusUnprocessableEntity,
+		},
+	}
+}
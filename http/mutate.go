@@ -0,0 +1,138 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	k8sadmissionv1 "k8s.io/api/admission/v1"
+)
+
+type jsonPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+func (m *manager) mutateTopologyHandler(w http.ResponseWriter, r *http.Request) {
+	m.logRequest(r)
+
+	review, err := readAdmissionReview(r)
+	if err != nil {
+		m.logger.Warnf("failed reading topology mutation admission review, error: %s", err)
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	response := m.mutateTopology(review.Request)
+
+	err = writeAdmissionReview(w, review, response)
+	if err != nil {
+		m.logger.Warnf("failed writing topology mutation admission review, error: %s", err)
+	}
+}
+
+func (m *manager) mutateTopology(
+	request *k8sadmissionv1.AdmissionRequest,
+) *k8sadmissionv1.AdmissionResponse {
+	if request.Operation != k8sadmissionv1.Create {
+		return allowedAdmissionResponse()
+	}
+
+	topology := &clabernetesapisv1alpha1.Topology{}
+
+	err := json.Unmarshal(request.Object.Raw, topology)
+	if err != nil {
+		return deniedAdmissionResponse(
+			fmt.Errorf("%w: failed decoding topology: %w", claberneteserrors.ErrParse, err),
+		)
+	}
+
+	rawTopology := map[string]any{}
+
+	err = json.Unmarshal(request.Object.Raw, &rawTopology)
+	if err != nil {
+		return deniedAdmissionResponse(
+			fmt.Errorf("%w: failed decoding topology: %w", claberneteserrors.ErrParse, err),
+		)
+	}
+
+	specDefaults := clabernetescontrollerstopology.ResolveSpecDefaults(
+		topology,
+		clabernetesconfig.GetManager,
+	)
+
+	patch := buildDefaultsPatch(rawTopology, specDefaults)
+	if len(patch) == 0 {
+		return allowedAdmissionResponse()
+	}
+
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		m.logger.Warnf("failed marshaling topology defaults patch, error: %s", err)
+
+		return allowedAdmissionResponse()
+	}
+
+	patchType := k8sadmissionv1.PatchTypeJSONPatch
+
+	response := allowedAdmissionResponse()
+	response.Patch = patchBytes
+	response.PatchType = &patchType
+
+	return response
+}
+
+// buildDefaultsPatch returns the json patch operations required to set the given spec defaults on
+// the raw topology object. Any parent objects missing from the raw object are added along the way
+// -- we track those in the raw object as we go so later defaults land in the newly added parents.
+func buildDefaultsPatch(
+	rawTopology map[string]any,
+	specDefaults []clabernetescontrollerstopology.SpecDefault,
+) []jsonPatchOperation {
+	patch := make([]jsonPatchOperation, 0)
+
+	for _, specDefault := range specDefaults {
+		path := append([]string{"spec"}, specDefault.Path...)
+
+		parent := rawTopology
+
+		for idx, key := range path {
+			pointer := "/" + strings.Join(path[:idx+1], "/")
+
+			if idx == len(path)-1 {
+				patch = append(
+					patch,
+					jsonPatchOperation{Op: "add", Path: pointer, Value: specDefault.Value},
+				)
+
+				parent[key] = specDefault.Value
+
+				break
+			}
+
+			child, ok := parent[key].(map[string]any)
+			if !ok {
+				child = map[string]any{}
+
+				patch = append(
+					patch,
+					jsonPatchOperation{Op: "add", Path: pointer, Value: map[string]any{}},
+				)
+
+				parent[key] = child
+			}
+
+			parent = child
+		}
+	}
+
+	return patch
+}
@@ -97,6 +97,14 @@ func (m *manager) Start() {
 		aliveRoute,
 		m.aliveHandler,
 	)
+	mux.HandleFunc(
+		clabernetesconstants.ValidateTopologyWebhookPath,
+		m.validateTopologyHandler,
+	)
+	mux.HandleFunc(
+		clabernetesconstants.MutateTopologyWebhookPath,
+		m.mutateTopologyHandler,
+	)
 
 	m.server = &http.Server{
 		BaseContext: func(_ net.Listener) context.Context {
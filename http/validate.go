@@ -0,0 +1,69 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	k8sadmissionv1 "k8s.io/api/admission/v1"
+)
+
+func (m *manager) validateTopologyHandler(w http.ResponseWriter, r *http.Request) {
+	m.logRequest(r)
+
+	review, err := readAdmissionReview(r)
+	if err != nil {
+		m.logger.Warnf("failed reading topology validation admission review, error: %s", err)
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	response := m.validateTopology(review.Request)
+
+	err = writeAdmissionReview(w, review, response)
+	if err != nil {
+		m.logger.Warnf("failed writing topology validation admission review, error: %s", err)
+	}
+}
+
+func (m *manager) validateTopology(
+	request *k8sadmissionv1.AdmissionRequest,
+) *k8sadmissionv1.AdmissionResponse {
+	if request.Operation != k8sadmissionv1.Create && request.Operation != k8sadmissionv1.Update {
+		return allowedAdmissionResponse()
+	}
+
+	topology := &clabernetesapisv1alpha1.Topology{}
+
+	err := json.Unmarshal(request.Object.Raw, topology)
+	if err != nil {
+		return deniedAdmissionResponse(
+			fmt.Errorf("%w: failed decoding topology: %w", claberneteserrors.ErrParse, err),
+		)
+	}
+
+	if topology.DeletionTimestamp != nil {
+		// dont block anything (like finalizer removal) from happening on a topology that is on
+		// its way out
+		return allowedAdmissionResponse()
+	}
+
+	err = clabernetescontrollerstopology.ValidateTopology(m.logger, topology)
+	if err != nil {
+		m.logger.Infof(
+			"rejecting topology '%s/%s', error: %s",
+			request.Namespace,
+			request.Name,
+			err,
+		)
+
+		return deniedAdmissionResponse(err)
+	}
+
+	return allowedAdmissionResponse()
+}
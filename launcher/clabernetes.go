@@ -2,28 +2,27 @@ package launcher
 
 import (
 	"context"
-	"fmt"
 	"math/rand"
-	"net"
 	"os"
-	"strconv"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
 	clabernetesgeneratedclientset "github.com/srl-labs/clabernetes/generated/clientset"
+	claberneteslauncherconnectivity "github.com/srl-labs/clabernetes/launcher/connectivity"
 	claberneteslogging "github.com/srl-labs/clabernetes/logging"
 	clabernetesutil "github.com/srl-labs/clabernetes/util"
-	"golang.org/x/crypto/ssh"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
-	maxDockerLaunchAttempts  = 10
-	containerCheckInterval   = 5 * time.Second
-	statusProbeCheckInterval = 30 * time.Second
-	statusProbeCheckTimeout  = 5 * time.Second
-	clientDefaultTimeout     = time.Minute
-	defaultSSHPort           = 22
+	maxDockerLaunchAttempts         = 10
+	containerCheckInterval          = 5 * time.Second
+	statusProbeCheckIntervalSeconds = 20
+	statusProbeCheckTimeoutSeconds  = 5
+	clientDefaultTimeout            = time.Minute
 )
 
 // StartClabernetes is a function that starts the clabernetes launcher. It cannot fail, only panic.
@@ -62,6 +61,7 @@ func StartClabernetes() {
 		ctx:                   ctx,
 		cancel:                cancel,
 		kubeClabernetesClient: mustNewKubeClabernetesClient(clabernetesLogger),
+		kubeClient:            mustNewKubeClient(clabernetesLogger),
 		appName: clabernetesutil.GetEnvStrOrDefault(
 			clabernetesconstants.AppNameEnv,
 			clabernetesconstants.AppNameDefault,
@@ -72,8 +72,13 @@ func StartClabernetes() {
 		nodeLogger:           nodeLogger,
 		imageName:            os.Getenv(clabernetesconstants.LauncherNodeImageEnv),
 		imagePullThroughMode: os.Getenv(clabernetesconstants.LauncherImagePullThroughModeEnv),
+		isSegment: os.Getenv(
+			clabernetesconstants.LauncherSegmentEnv,
+		) == clabernetesconstants.True,
 	}
 
+	clabernetesInstance.metrics = newLauncherMetrics(clabernetesInstance)
+
 	clabernetesInstance.startup()
 }
 
@@ -84,6 +89,7 @@ type clabernetes struct {
 	cancel context.CancelFunc
 
 	kubeClabernetesClient *clabernetesgeneratedclientset.Clientset
+	kubeClient            *kubernetes.Clientset
 
 	appName  string
 	nodeName string
@@ -95,12 +101,26 @@ type clabernetes struct {
 	imageName            string
 	imagePullThroughMode string
 
+	// isSegment indicates that the node this launcher represents is a segment (bridge) node
+	// rather than a "real" containerized node -- there is no image to pull, no container to
+	// watch and nothing to probe, the launcher just owns the bridge.
+	isSegment bool
+
 	// containerIDs holds *all* ids of containers running --in theory we could have other side-car
-	// type stuff running so just catching all them here so we know if/when things fail
-	containerIDs []string
+	// type stuff running so just catching all them here so we know if/when things fail. the ids
+	// are read by the metrics server too, so always go through load/storeContainerIDs for these.
+	containerIDs     []string
+	containerIDsLock sync.RWMutex
 	// meanwhile nodeContainerID is the container id of hte specific node this launcher represents
 	// -- meaning the single node from the original topology this launcher is representing
 	nodeContainerID string
+	// redeployLock is held (for writing) while the launcher redeploys its containerlab nodes so
+	// that watchContainers does not mistake the containers going away for a failure
+	redeployLock sync.RWMutex
+
+	connectivityManager claberneteslauncherconnectivity.Manager
+
+	metrics *launcherMetrics
 }
 
 func (c *clabernetes) startup() {
@@ -110,18 +130,28 @@ func (c *clabernetes) startup() {
 
 	c.containerlabVersion()
 	c.setup()
-	c.image()
+
+	if c.isSegment {
+		c.segment()
+	} else {
+		c.image()
+	}
+
+	c.waitForNodes()
 	c.launch()
 	c.connectivity()
 
 	go c.imageCleanup()
 	go c.runProbes()
 	go c.watchContainers()
+	go c.serveMetrics()
 
 	c.logger.Info("running for forever or until sigint...")
 
 	<-c.ctx.Done()
 
+	c.teardown()
+
 	claberneteslogging.GetManager().Flush()
 }
 
@@ -154,6 +184,21 @@ func (c *clabernetes) setup() {
 		c.handleMounts()
 	}
 
+	if containerRuntime() == clabernetesconstants.LauncherRuntimePodman {
+		c.setupPodman()
+	} else {
+		c.setupDocker()
+	}
+
+	c.logger.Debug("getting files from url if requested...")
+
+	err := c.getFilesFromURL()
+	if err != nil {
+		c.logger.Fatalf("failed getting file(s) from remote url, err: %s", err)
+	}
+}
+
+func (c *clabernetes) setupDocker() {
 	if daemonConfigExists() {
 		c.logger.Infof("%q exists, skipping insecure registries", dockerDaemonConfig)
 	} else {
@@ -186,12 +231,21 @@ func (c *clabernetes) setup() {
 
 		c.logger.Warn("docker started, but using legacy ip tables")
 	}
+}
 
-	c.logger.Debug("getting files from url if requested...")
+func (c *clabernetes) setupPodman() {
+	c.logger.Debug("configure insecure registries if requested...")
 
-	err = c.getFilesFromURL()
+	err := handlePodmanInsecureRegistries()
 	if err != nil {
-		c.logger.Fatalf("failed getting file(s) from remote url, err: %s", err)
+		c.logger.Fatalf("failed configuring insecure podman registries, err: %s", err)
+	}
+
+	c.logger.Debug("ensuring podman service is running...")
+
+	err = startPodman(c.ctx, c.logger)
+	if err != nil {
+		c.logger.Fatalf("failed ensuring podman service is running, err: %s", err)
 	}
 }
 
@@ -208,7 +262,24 @@ func (c *clabernetes) launch() {
 		c.reportContainerLaunchFail()
 	}
 
-	c.containerIDs, err = getContainerIDs(c.ctx, false)
+	if c.isSegment {
+		c.logger.Debug("containerlab launched successfully")
+
+		return
+	}
+
+	err = c.discoverContainers()
+	if err != nil {
+		c.logger.Fatalf("failed determining node %q container id, err: %s", c.nodeName, err)
+	}
+
+	c.logger.Debug("containerlab launched successfully")
+}
+
+// discoverContainers determines the ids of the containers containerlab launched (and starts
+// tailing their logs) as well as the id of the node container.
+func (c *clabernetes) discoverContainers() error {
+	containerIDs, err := getContainerIDs(c.ctx, false)
 	if err != nil {
 		c.logger.Warnf(
 			"failed determining container ids will continue but will not log container output,"+
@@ -217,10 +288,12 @@ func (c *clabernetes) launch() {
 		)
 	}
 
-	if len(c.containerIDs) > 0 {
-		c.logger.Debugf("found container ids %q", c.containerIDs)
+	c.storeContainerIDs(containerIDs)
+
+	if len(containerIDs) > 0 {
+		c.logger.Debugf("found container ids %q", containerIDs)
 
-		err = tailContainerLogs(c.ctx, c.logger, c.nodeLogger, c.containerIDs)
+		err = tailContainerLogs(c.ctx, c.logger, c.nodeLogger, containerIDs)
 		if err != nil {
 			c.logger.Warnf("failed creating node log file, err: %s", err)
 		}
@@ -232,169 +305,35 @@ func (c *clabernetes) launch() {
 	}
 
 	c.nodeContainerID, err = getContainerIDForNodeName(c.ctx, c.nodeName)
-	if err != nil {
-		c.logger.Fatalf("failed determining node %q container id, err: %s", c.nodeName, err)
-	}
 
-	c.logger.Debug("containerlab launched successfully")
+	return err
 }
 
-func (c *clabernetes) runProbes() {
-	c.logger.Debug("starting status probe(s) if configured...")
-
-	tcpProbePort := clabernetesutil.GetEnvIntOrDefault(clabernetesconstants.LauncherTCPProbePort, 0)
-
-	sshProbePort := clabernetesutil.GetEnvIntOrDefault(
-		clabernetesconstants.LauncherSSHProbePort,
-		defaultSSHPort,
-	)
-
-	sshProbeUsername := os.Getenv(clabernetesconstants.LauncherSSHProbeUsername)
-
-	sshProbePassword := os.Getenv(clabernetesconstants.LauncherSSHProbePassword)
-
-	var runTCPProbe bool
+func (c *clabernetes) storeContainerIDs(containerIDs []string) {
+	c.containerIDsLock.Lock()
+	defer c.containerIDsLock.Unlock()
 
-	var runSSHProbe bool
-
-	if tcpProbePort != 0 {
-		c.logger.Debugf("will run tcp status probe to port %d", tcpProbePort)
-
-		runTCPProbe = true
-	}
-
-	if sshProbeUsername != "" && sshProbePassword != "" {
-		c.logger.Debugf(
-			"will run ssh status probe using username %s to port %d",
-			sshProbeUsername,
-			sshProbePort,
-		)
-
-		runSSHProbe = true
-	}
-
-	if !runTCPProbe && !runSSHProbe {
-		c.logger.Debug("no probes configured, skipping status probes...")
-
-		return
-	}
-
-	c.logger.Info("starting status probes...")
-
-	ticker := time.NewTicker(statusProbeCheckInterval)
-
-	var nodeAddr string
-
-	for range ticker.C {
-		if nodeAddr == "" {
-			var err error
-
-			nodeAddr, err = getContainerAddr(c.ctx, c.nodeContainerID)
-			if err != nil {
-				c.logger.Warnf(
-					"failed determining node %q address, error: %s",
-					c.nodeName,
-					err,
-				)
-
-				continue
-			}
-		}
-
-		tcpProbeOk := true
-		sshProbeOk := true
-
-		if runTCPProbe {
-			dialer := net.Dialer{
-				Timeout: statusProbeCheckTimeout,
-			}
-
-			tcpConn, err := dialer.Dial(
-				"tcp",
-				net.JoinHostPort(nodeAddr, strconv.Itoa(tcpProbePort)),
-			)
-			if err != nil {
-				tcpProbeOk = false
-			} else {
-				_ = tcpConn.Close()
-			}
-		}
-
-		if runSSHProbe {
-			sshProbeOk = probeSSH(sshProbePort, nodeAddr, sshProbeUsername, sshProbePassword)
-		}
-
-		var writeErr error
-
-		if tcpProbeOk && sshProbeOk {
-			writeErr = os.WriteFile(
-				clabernetesconstants.NodeStatusFile,
-				[]byte(clabernetesconstants.NodeStatusHealthy),
-				clabernetesconstants.PermissionsEveryoneAllPermissions,
-			)
-		} else {
-			writeErr = os.WriteFile(
-				clabernetesconstants.NodeStatusFile,
-				nil,
-				clabernetesconstants.PermissionsEveryoneAllPermissions,
-			)
-		}
-
-		if writeErr != nil {
-			c.logger.Criticalf(
-				"failed writing node status file, this probably should not happen, error: %s",
-				writeErr,
-			)
-
-			c.cancel()
-
-			return
-		}
-	}
+	c.containerIDs = containerIDs
 }
 
-func probeSSH(port int, nodeAddr, username, password string) bool {
-	sshConfig := &ssh.ClientConfig{
-		User: username,
-		Auth: []ssh.AuthMethod{
-			ssh.Password(password),
-			ssh.KeyboardInteractive(
-				func(_, _ string, questions []string, _ []bool) ([]string, error) {
-					answers := make([]string, len(questions))
-					for i := range answers {
-						answers[i] = password
-					}
-
-					return answers, nil
-				},
-			),
-		},
-		Timeout:         statusProbeCheckTimeout,
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(), //nolint:gosec
-	}
+// loadContainerIDs returns a copy of the ids of the containers the launcher is running.
+func (c *clabernetes) loadContainerIDs() []string {
+	c.containerIDsLock.RLock()
+	defer c.containerIDsLock.RUnlock()
 
-	conn, err := ssh.Dial(
-		"tcp",
-		fmt.Sprintf("%s:%d", nodeAddr, port),
-		sshConfig,
-	)
-	if err != nil {
-		return false
-	}
-
-	_ = conn.Close()
-
-	return true
+	return slices.Clone(c.containerIDs)
 }
 
 func (c *clabernetes) watchContainers() {
-	if len(c.containerIDs) == 0 {
+	if len(c.loadContainerIDs()) == 0 {
 		return
 	}
 
 	ticker := time.NewTicker(containerCheckInterval)
 
 	for range ticker.C {
+		c.redeployLock.RLock()
+
 		currentContainerIDs, err := getContainerIDs(c.ctx, false)
 		if err != nil {
 			c.logger.Warnf(
@@ -403,10 +342,14 @@ func (c *clabernetes) watchContainers() {
 			)
 		}
 
-		if len(currentContainerIDs) != len(c.containerIDs) {
+		expectedContainerIDs := c.loadContainerIDs()
+
+		c.redeployLock.RUnlock()
+
+		if len(currentContainerIDs) != len(expectedContainerIDs) {
 			c.logger.Criticalf(
 				"expected %d running containers, but got %d, sending done signal",
-				len(c.containerIDs),
+				len(expectedContainerIDs),
 				len(currentContainerIDs),
 			)
 
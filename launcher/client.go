@@ -3,6 +3,7 @@ package launcher
 import (
 	clabernetesgeneratedclientset "github.com/srl-labs/clabernetes/generated/clientset"
 	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
 
@@ -24,3 +25,22 @@ func mustNewKubeClabernetesClient(
 
 	return kubeClabernetesClient
 }
+
+func mustNewKubeClient(
+	logger claberneteslogging.Instance,
+) *kubernetes.Clientset {
+	kubeConfig, err := rest.InClusterConfig()
+	if err != nil {
+		logger.Fatalf("failed getting in cluster kubeconfig, err: %s", err)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		logger.Fatalf(
+			"failed creating kube client from in cluster kubeconfig, err: %s",
+			err,
+		)
+	}
+
+	return kubeClient
+}
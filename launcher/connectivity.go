@@ -31,6 +31,8 @@ func (c *clabernetes) connectivity() {
 	}
 
 	connectivityManager.Run()
+
+	c.connectivityManager = connectivityManager
 }
 
 func (c *clabernetes) getTunnels() ([]*clabernetesapisv1alpha1.PointToPointTunnel, error) {
@@ -0,0 +1,87 @@
+//go:build linux
+
+package connectivity
+
+import (
+	"net"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	"github.com/vishvananda/netlink"
+)
+
+// geneveNetlinkKind is the netlink tunnel kind for geneve tunnels.
+var geneveNetlinkKind = &netlinkTunnelKind{ //nolint:gochecknoglobals
+	name:            "geneve",
+	interfacePrefix: "gn-",
+	overhead:        50,
+	newLink: func(
+		attrs netlink.LinkAttrs,
+		tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+		remoteIP net.IP,
+		_ netlink.Link,
+	) netlink.Link {
+		return &netlink.Geneve{
+			LinkAttrs: attrs,
+			ID:        uint32(tunnel.TunnelID), //nolint:gosec
+			Remote:    remoteIP,
+			Dport:     clabernetesconstants.GENEVEServicePort,
+		}
+	},
+	conforms: func(
+		existingLink netlink.Link,
+		tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+		remoteIP net.IP,
+	) bool {
+		existingGeneve, ok := existingLink.(*netlink.Geneve)
+		if !ok {
+			return false
+		}
+
+		return existingGeneve.ID == uint32(tunnel.TunnelID) && //nolint:gosec
+			existingGeneve.Remote.Equal(remoteIP) &&
+			existingGeneve.Dport == clabernetesconstants.GENEVEServicePort
+	},
+}
+
+type geneveManager struct {
+	*common
+}
+
+func (m *geneveManager) Run() {
+	m.logger.Info(
+		"connectivity mode is 'geneve', setting up any required tunnels...",
+	)
+
+	err := m.reconcileNetlinkTunnels(geneveNetlinkKind, m.initialTunnels, m.resolveTunnelService)
+	if err != nil {
+		m.logger.Fatalf("failed setting up geneve tunnels, error: %s", err)
+	}
+
+	m.logger.Debug("initial geneve tunnel creation complete")
+
+	m.updateImpairments(m.initialTunnels)
+	m.updateLinkStates(m.initialTunnels)
+
+	m.logger.Debug("start connectivity custom resource watch...")
+
+	go m.watchConnectivity(m.handleConnectivityUpdate, m.forgetAppliedState)
+
+	go m.runTunnelStatusReporter()
+
+	m.logger.Debug("geneve connectivity setup complete")
+}
+
+func (m *geneveManager) handleConnectivityUpdate(
+	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	err := m.reconcileNetlinkTunnels(geneveNetlinkKind, tunnels, m.resolveTunnelService)
+	if err != nil {
+		return err
+	}
+
+	m.updateImpairments(tunnels)
+	m.updateLinkStates(tunnels)
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package connectivity
+
+import (
+	"os/exec"
+	"reflect"
+	"strconv"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+)
+
+// updateImpairments applies the impairments of the given tunnels to the local interfaces of the
+// tunnels via "containerlab tools netem", resetting the impairments of interfaces that are no
+// longer impaired. Interfaces whose impairment did not change since the last update are left
+// alone. Failing to (re)set impairments is not fatal, the interface is simply retried on the next
+// update.
+func (c *common) updateImpairments(tunnels []*clabernetesapisv1alpha1.PointToPointTunnel) {
+	if c.currentImpairments == nil {
+		c.currentImpairments = make(map[string]*clabernetesapisv1alpha1.PointToPointTunnel)
+	}
+
+	desiredImpairments := make(map[string]*clabernetesapisv1alpha1.PointToPointTunnel)
+
+	for _, tunnel := range tunnels {
+		if tunnel.Impairment == nil {
+			continue
+		}
+
+		desiredImpairments[tunnelKey(tunnel)] = tunnel
+	}
+
+	for key, existingTunnel := range c.currentImpairments {
+		_, ok := desiredImpairments[key]
+		if ok {
+			continue
+		}
+
+		err := c.runContainerlabNetemReset(existingTunnel.LocalNode, existingTunnel.LocalInterface)
+		if err != nil {
+			c.logger.Warnf(
+				"failed resetting impairment for local interface '%s', error: %s",
+				key,
+				err,
+			)
+
+			continue
+		}
+
+		delete(c.currentImpairments, key)
+	}
+
+	for key, tunnel := range desiredImpairments {
+		existingTunnel, ok := c.currentImpairments[key]
+		if ok && reflect.DeepEqual(existingTunnel.Impairment, tunnel.Impairment) {
+			continue
+		}
+
+		err := c.runContainerlabNetemSet(tunnel.LocalNode, tunnel.LocalInterface, tunnel.Impairment)
+		if err != nil {
+			c.logger.Warnf(
+				"failed setting impairment for local interface '%s', error: %s",
+				key,
+				err,
+			)
+
+			continue
+		}
+
+		c.currentImpairments[key] = tunnel
+	}
+}
+
+func (c *common) runContainerlabNetemSet(
+	localNodeName,
+	localInterface string,
+	impairment *clabernetesapisv1alpha1.LinkImpairment,
+) error {
+	args := []string{
+		"tools",
+		"netem",
+		"set",
+		"--node",
+		localNodeName,
+		"--interface",
+		localInterface,
+	}
+
+	if impairment.Delay != "" {
+		args = append(args, "--delay", impairment.Delay)
+
+		if impairment.Jitter != "" {
+			args = append(args, "--jitter", impairment.Jitter)
+		}
+	}
+
+	if impairment.Loss != "" {
+		args = append(args, "--loss", impairment.Loss)
+	}
+
+	if impairment.Rate > 0 {
+		args = append(args, "--rate", strconv.Itoa(impairment.Rate))
+	}
+
+	args = append(args, clabernetesutilcontainerlab.RuntimeArgs()...)
+
+	cmd := exec.CommandContext(c.ctx, "containerlab", args...) //nolint:gosec
+
+	c.logger.Debugf(
+		"using following args for setting link impairment (via containerlab) '%s'", cmd.Args,
+	)
+
+	cmd.Stdout = c.logger
+	cmd.Stderr = c.logger
+
+	return cmd.Run()
+}
+
+func (c *common) runContainerlabNetemReset(localNodeName, localInterface string) error {
+	args := []string{
+		"tools",
+		"netem",
+		"reset",
+		"--node",
+		localNodeName,
+		"--interface",
+		localInterface,
+	}
+
+	args = append(args, clabernetesutilcontainerlab.RuntimeArgs()...)
+
+	cmd := exec.CommandContext(c.ctx, "containerlab", args...) //nolint:gosec
+
+	c.logger.Debugf(
+		"using following args for resetting link impairment (via containerlab) '%s'", cmd.Args,
+	)
+
+	cmd.Stdout = c.logger
+	cmd.Stderr = c.logger
+
+	return cmd.Run()
+}
@@ -0,0 +1,110 @@
+package connectivity
+
+import (
+	"fmt"
+	"os/exec"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+)
+
+// withoutLiveSettings returns a copy of the given tunnel without its impairment and state -- both
+// are applied independently of the tunnel itself, so changing them must never cause a tunnel to
+// be re-created.
+func withoutLiveSettings(
+	tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+) *clabernetesapisv1alpha1.PointToPointTunnel {
+	tunnelCopy := tunnel.DeepCopy()
+	tunnelCopy.Impairment = nil
+	tunnelCopy.State = ""
+
+	return tunnelCopy
+}
+
+// desiredLinkState returns the desired administrative state of the given tunnel, tunnels without
+// an explicit state are "up".
+func desiredLinkState(tunnel *clabernetesapisv1alpha1.PointToPointTunnel) string {
+	if tunnel.State == "" {
+		return clabernetesconstants.LinkStateUp
+	}
+
+	return tunnel.State
+}
+
+// updateLinkStates sets the (launcher side) interfaces of the given tunnels administratively up or
+// down as desired, and then reports the tunnel states in the connectivity status. Interfaces are
+// up when created, so they are only touched when they should be down or when they were previously
+// set down. Failing to set an interface state is not fatal, the interface is simply retried on the
+// next update.
+func (c *common) updateLinkStates(tunnels []*clabernetesapisv1alpha1.PointToPointTunnel) {
+	c.statusLock.Lock()
+
+	if c.currentLinkStates == nil {
+		c.currentLinkStates = make(map[string]string)
+	}
+
+	desiredTunnels := make(map[string]*clabernetesapisv1alpha1.PointToPointTunnel)
+
+	for _, tunnel := range tunnels {
+		desiredTunnels[tunnelKey(tunnel)] = tunnel
+	}
+
+	for key := range c.currentLinkStates {
+		_, ok := desiredTunnels[key]
+		if !ok {
+			delete(c.currentLinkStates, key)
+		}
+	}
+
+	for key, tunnel := range desiredTunnels {
+		desiredState := desiredLinkState(tunnel)
+
+		currentState, ok := c.currentLinkStates[key]
+		if ok && currentState == desiredState {
+			continue
+		}
+
+		if !ok && desiredState == clabernetesconstants.LinkStateUp {
+			c.currentLinkStates[key] = desiredState
+
+			continue
+		}
+
+		err := c.runIPLinkSet(tunnel.LocalNode, tunnel.LocalInterface, desiredState)
+		if err != nil {
+			c.logger.Warnf(
+				"failed setting local interface '%s' %s, error: %s",
+				key,
+				desiredState,
+				err,
+			)
+
+			continue
+		}
+
+		c.currentLinkStates[key] = desiredState
+	}
+
+	c.statusLock.Unlock()
+
+	c.reportTunnelStatuses(tunnels)
+}
+
+func (c *common) runIPLinkSet(localNodeName, localInterface, state string) error {
+	cmd := exec.CommandContext( //nolint:gosec
+		c.ctx,
+		"ip",
+		"link",
+		"set",
+		"dev",
+		sanitizeInterfaceName(fmt.Sprintf("%s-%s", localNodeName, localInterface)),
+		state,
+	)
+
+	c.logger.Debugf("using following args for setting link state '%s'", cmd.Args)
+
+	cmd.Stdout = c.logger
+	cmd.Stderr = c.logger
+
+	return cmd.Run()
+}
@@ -2,6 +2,8 @@ package connectivity
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
 	clabernetesgeneratedclientset "github.com/srl-labs/clabernetes/generated/clientset"
@@ -19,6 +21,10 @@ type Manager interface {
 	// expected for the Run method to just call logger.Fatal if there is any issue as this would
 	// prevent c9s from doing anything useful anyway!
 	Run()
+	// Resync re-applies the tunnels (and their impairments and states) from scratch -- this is
+	// used after the launcher redeployed its containerlab nodes, as that replaces the interfaces
+	// the tunnels were attached to.
+	Resync()
 }
 
 type common struct {
@@ -27,4 +33,49 @@ type common struct {
 	logger            claberneteslogging.Instance
 	clabernetesClient *clabernetesgeneratedclientset.Clientset
 	initialTunnels    []*clabernetesapisv1alpha1.PointToPointTunnel
+
+	// resync signals the connectivity watch to re-apply the tunnels, see Resync
+	resync chan struct{}
+
+	// currentImpairments holds the tunnels (by tunnel key) whose impairments are currently
+	// applied
+	currentImpairments map[string]*clabernetesapisv1alpha1.PointToPointTunnel
+
+	// statusLock guards the link states and tunnel statuses below as they are used by both the
+	// connectivity watch and the periodic tunnel status reporting
+	statusLock sync.Mutex
+
+	// currentLinkStates holds the administrative state (by tunnel key) currently applied to the
+	// interfaces of the tunnels
+	currentLinkStates map[string]string
+
+	// reportedTunnels holds the tunnels whose status is reported in the connectivity status, and
+	// lastTunnelStatuses the statuses that were last written successfully
+	reportedTunnels    []*clabernetesapisv1alpha1.PointToPointTunnel
+	lastTunnelStatuses []clabernetesapisv1alpha1.PointToPointTunnelStatus
+}
+
+// tunnelKey returns the key of the given tunnel in the maps of applied state -- the launcher of a
+// node group hosts the tunnels of all nodes of the group, so the local interface name alone does
+// not identify a tunnel.
+func tunnelKey(tunnel *clabernetesapisv1alpha1.PointToPointTunnel) string {
+	return fmt.Sprintf("%s/%s", tunnel.LocalNode, tunnel.LocalInterface)
+}
+
+func (c *common) Resync() {
+	select {
+	case c.resync <- struct{}{}:
+	default:
+		// a resync is already pending, that one will pick up the current state of things anyway
+	}
+}
+
+// forgetAppliedState forgets the impairments and link states applied so far, so that the next
+// update applies them all over again.
+func (c *common) forgetAppliedState() {
+	c.currentImpairments = nil
+
+	c.statusLock.Lock()
+	c.currentLinkStates = nil
+	c.statusLock.Unlock()
 }
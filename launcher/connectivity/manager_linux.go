@@ -28,6 +28,7 @@ func NewManager(
 		logger:            logger,
 		clabernetesClient: clabernetesClient,
 		initialTunnels:    initialTunnels,
+		resync:            make(chan struct{}, 1),
 	}
 
 	switch connectivityKind {
@@ -39,6 +40,18 @@ func NewManager(
 		return &slurpeethManager{
 			common: c,
 		}, nil
+	case clabernetesconstants.ConnectivityVXLANNetlink:
+		return &vxlanNetlinkManager{
+			common: c,
+		}, nil
+	case clabernetesconstants.ConnectivityGENEVE:
+		return &geneveManager{
+			common: c,
+		}, nil
+	case clabernetesconstants.ConnectivityWireGuard:
+		return &wireGuardManager{
+			common: c,
+		}, nil
 	default:
 		return nil, fmt.Errorf(
 			"%w: unknown connectivity kind, cannot create connectivity manager",
@@ -28,6 +28,7 @@ func NewManager(
 		logger:            logger,
 		clabernetesClient: clabernetesClient,
 		initialTunnels:    initialTunnels,
+		resync:            make(chan struct{}, 1),
 	}
 
 	switch connectivityKind {
@@ -36,8 +37,9 @@ func NewManager(
 			common: c,
 		}, nil
 	default:
-		// just excluding slurpeeth for easy testing/linting reasons basically since we assume this
-		// will only ever run on linux anyway
+		// just excluding slurpeeth and the netlink based flavors (vxlan-netlink, geneve and
+		// wireguard) for easy testing/linting reasons basically since we assume this will only
+		// ever run on linux anyway
 		return nil, fmt.Errorf(
 			"%w: unknown connectivity kind, cannot create connectivity manager",
 			claberneteserrors.ErrLaunch,
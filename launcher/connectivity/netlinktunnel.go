@@ -0,0 +1,412 @@
+//go:build linux
+
+package connectivity
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strings"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// maxInterfaceNameLength is the maximum length of a linux interface name.
+	maxInterfaceNameLength = 15
+
+	// tcIngressMajor is the major number of the ingress qdisc handle (ffff:).
+	tcIngressMajor = 0xffff
+)
+
+// netlinkTunnelKind describes a kind of tunnel interface managed (natively) via netlink, for
+// example vxlan or geneve.
+type netlinkTunnelKind struct {
+	// name is the name of the kind, used in log messages and in the alias set on the tunnel
+	// interfaces -- the remainder of the alias is the name of the (launcher side) local interface
+	// the tunnel interface is stitched to. This lets us recover the tunnels from the kernel state
+	// rather than keeping track of them ourselves.
+	name string
+	// interfacePrefix is the prefix of the names of the tunnel interfaces.
+	interfacePrefix string
+	// overhead is the encapsulation overhead that is subtracted from the mtu of the underlay
+	// interface.
+	overhead int
+	// newLink returns the link to create for the given tunnel.
+	newLink func(
+		attrs netlink.LinkAttrs,
+		tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+		remoteIP net.IP,
+		underlayInterface netlink.Link,
+	) netlink.Link
+	// conforms returns true if the given existing link matches the given tunnel.
+	conforms func(
+		existingLink netlink.Link,
+		tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+		remoteIP net.IP,
+	) bool
+}
+
+func (k *netlinkTunnelKind) aliasPrefix() string {
+	return fmt.Sprintf("clabernetes-%s:", k.name)
+}
+
+// interfaceName returns the name of the tunnel interface for the given local interface, this is
+// the prefix plus the local interface name (like containerlab names them) unless that exceeds the
+// maximum interface name length, in which case a hash of the local interface name is used instead.
+func (k *netlinkTunnelKind) interfaceName(localInterfaceName string) string {
+	name := k.interfacePrefix + localInterfaceName
+	if len(name) <= maxInterfaceNameLength {
+		return name
+	}
+
+	h := fnv.New32a()
+
+	_, _ = h.Write([]byte(localInterfaceName))
+
+	return fmt.Sprintf("%s%08x", k.interfacePrefix, h.Sum32())
+}
+
+// reconcileNetlinkTunnels diffs the given (desired) tunnels against the tunnel interfaces of the
+// given kind in the kernel -- tunnel interfaces for tunnels that no longer exist are deleted,
+// tunnels that have no (or an outdated) tunnel interface get one created, and everything else is
+// left untouched. The remote address of a tunnel is resolved with the given resolve func.
+func (c *common) reconcileNetlinkTunnels(
+	kind *netlinkTunnelKind,
+	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
+	resolveRemote func(tunnel *clabernetesapisv1alpha1.PointToPointTunnel) (net.IP, error),
+) error {
+	existingLinks, err := listNetlinkTunnels(kind)
+	if err != nil {
+		return err
+	}
+
+	desiredTunnels := make(map[string]*clabernetesapisv1alpha1.PointToPointTunnel)
+
+	for _, tunnel := range tunnels {
+		localInterfaceName := sanitizeInterfaceName(
+			fmt.Sprintf("%s-%s", tunnel.LocalNode, tunnel.LocalInterface),
+		)
+
+		desiredTunnels[localInterfaceName] = tunnel
+	}
+
+	for localInterfaceName, existingLink := range existingLinks {
+		_, ok := desiredTunnels[localInterfaceName]
+		if ok {
+			continue
+		}
+
+		c.logger.Debugf(
+			"deleting extraneous %s interface '%s' for local interface '%s'",
+			kind.name,
+			existingLink.Attrs().Name,
+			localInterfaceName,
+		)
+
+		err = deleteNetlinkTunnel(localInterfaceName, existingLink)
+		if err != nil {
+			return err
+		}
+	}
+
+	for localInterfaceName, tunnel := range desiredTunnels {
+		remoteIP, err := resolveRemote(tunnel)
+		if err != nil {
+			return fmt.Errorf(
+				"%w: failed resolving remote of tunnel to remote node '%s': %w",
+				claberneteserrors.ErrConnectivity,
+				tunnel.RemoteNode,
+				err,
+			)
+		}
+
+		existingLink, ok := existingLinks[localInterfaceName]
+		if ok {
+			if kind.conforms(existingLink, tunnel, remoteIP) &&
+				tcRedirectsExist(localInterfaceName, existingLink) {
+				continue
+			}
+
+			c.logger.Debugf(
+				"%s interface '%s' for local interface '%s' is outdated, re-creating",
+				kind.name,
+				existingLink.Attrs().Name,
+				localInterfaceName,
+			)
+
+			err = deleteNetlinkTunnel(localInterfaceName, existingLink)
+			if err != nil {
+				return err
+			}
+		}
+
+		c.logger.Debugf(
+			"creating %s interface for local interface '%s' with id %d and remote '%s'",
+			kind.name,
+			localInterfaceName,
+			tunnel.TunnelID,
+			remoteIP,
+		)
+
+		err = createNetlinkTunnel(kind, localInterfaceName, tunnel, remoteIP)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listNetlinkTunnels returns the tunnel interfaces of the given kind keyed by the name of the local
+// interface they are stitched to.
+func listNetlinkTunnels(kind *netlinkTunnelKind) (map[string]netlink.Link, error) {
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: failed listing interfaces: %w",
+			claberneteserrors.ErrConnectivity,
+			err,
+		)
+	}
+
+	tunnelLinks := make(map[string]netlink.Link)
+
+	for _, link := range links {
+		localInterfaceName, ok := strings.CutPrefix(link.Attrs().Alias, kind.aliasPrefix())
+		if !ok {
+			continue
+		}
+
+		tunnelLinks[localInterfaceName] = link
+	}
+
+	return tunnelLinks, nil
+}
+
+// createNetlinkTunnel creates the tunnel interface of the given kind for the given tunnel and
+// stitches it to the local interface with tc redirects in both directions.
+func createNetlinkTunnel(
+	kind *netlinkTunnelKind,
+	localInterfaceName string,
+	tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+	remoteIP net.IP,
+) error {
+	localInterface, err := netlink.LinkByName(localInterfaceName)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed finding local interface '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			localInterfaceName,
+			err,
+		)
+	}
+
+	routes, err := netlink.RouteGet(remoteIP)
+	if err != nil || len(routes) == 0 {
+		return fmt.Errorf(
+			"%w: failed finding route to remote '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			remoteIP,
+			err,
+		)
+	}
+
+	underlayInterface, err := netlink.LinkByIndex(routes[0].LinkIndex)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed finding underlay interface for remote '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			remoteIP,
+			err,
+		)
+	}
+
+	tunnelInterfaceName := kind.interfaceName(localInterfaceName)
+
+	err = netlink.LinkAdd(kind.newLink(
+		netlink.LinkAttrs{
+			Name: tunnelInterfaceName,
+			MTU:  underlayInterface.Attrs().MTU - kind.overhead,
+		},
+		tunnel,
+		remoteIP,
+		underlayInterface,
+	))
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed creating %s interface '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			kind.name,
+			tunnelInterfaceName,
+			err,
+		)
+	}
+
+	tunnelInterface, err := netlink.LinkByName(tunnelInterfaceName)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed finding created %s interface '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			kind.name,
+			tunnelInterfaceName,
+			err,
+		)
+	}
+
+	err = netlink.LinkSetAlias(tunnelInterface, kind.aliasPrefix()+localInterfaceName)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed setting alias on %s interface '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			kind.name,
+			tunnelInterfaceName,
+			err,
+		)
+	}
+
+	err = netlink.LinkSetUp(tunnelInterface)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed setting %s interface '%s' up: %w",
+			claberneteserrors.ErrConnectivity,
+			kind.name,
+			tunnelInterfaceName,
+			err,
+		)
+	}
+
+	err = setTcRedirect(localInterface, tunnelInterface)
+	if err != nil {
+		return err
+	}
+
+	return setTcRedirect(tunnelInterface, localInterface)
+}
+
+// deleteNetlinkTunnel deletes the given tunnel interface (which removes its tc config with it) and
+// the ingress qdisc (and therefore the redirect) of the local interface it was stitched to.
+func deleteNetlinkTunnel(localInterfaceName string, existingLink netlink.Link) error {
+	err := netlink.LinkDel(existingLink)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed deleting tunnel interface '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			existingLink.Attrs().Name,
+			err,
+		)
+	}
+
+	localInterface, err := netlink.LinkByName(localInterfaceName)
+	if err != nil {
+		// local interface is gone too, so there is no redirect left to clean up
+		return nil //nolint:nilerr
+	}
+
+	err = netlink.QdiscDel(ingressQdisc(localInterface))
+	if err != nil && !errors.Is(err, unix.ENOENT) && !errors.Is(err, unix.EINVAL) {
+		return fmt.Errorf(
+			"%w: failed deleting ingress qdisc of local interface '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			localInterfaceName,
+			err,
+		)
+	}
+
+	return nil
+}
+
+func ingressQdisc(link netlink.Link) *netlink.Ingress {
+	return &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(tcIngressMajor, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+}
+
+// setTcRedirect (re)sets the ingress qdisc of the source interface with a single filter that
+// redirects all traffic to the egress of the destination interface.
+func setTcRedirect(source, destination netlink.Link) error {
+	qdisc := ingressQdisc(source)
+
+	// drop any existing ingress qdisc, and with it any stale redirect filter, so we always end up
+	// with exactly one redirect
+	_ = netlink.QdiscDel(qdisc)
+
+	err := netlink.QdiscAdd(qdisc)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed adding ingress qdisc to interface '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			source.Attrs().Name,
+			err,
+		)
+	}
+
+	err = netlink.FilterAdd(&netlink.U32{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: source.Attrs().Index,
+			Parent:    netlink.MakeHandle(tcIngressMajor, 0),
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		ClassId: netlink.MakeHandle(1, 1),
+		Actions: []netlink.Action{
+			netlink.NewMirredAction(destination.Attrs().Index),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed adding redirect filter from interface '%s' to '%s': %w",
+			claberneteserrors.ErrConnectivity,
+			source.Attrs().Name,
+			destination.Attrs().Name,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// tcRedirectsExist returns true if both directions of the tc redirect between the given local
+// interface and tunnel interface are in place.
+func tcRedirectsExist(localInterfaceName string, tunnelInterface netlink.Link) bool {
+	localInterface, err := netlink.LinkByName(localInterfaceName)
+	if err != nil {
+		return false
+	}
+
+	return tcRedirectExists(localInterface, tunnelInterface) &&
+		tcRedirectExists(tunnelInterface, localInterface)
+}
+
+// tcRedirectExists returns true if the ingress of the source interface has a filter redirecting
+// traffic to the destination interface.
+func tcRedirectExists(source, destination netlink.Link) bool {
+	filters, err := netlink.FilterList(source, netlink.MakeHandle(tcIngressMajor, 0))
+	if err != nil {
+		return false
+	}
+
+	for _, filter := range filters {
+		u32Filter, ok := filter.(*netlink.U32)
+		if !ok {
+			continue
+		}
+
+		for _, action := range u32Filter.Actions {
+			mirredAction, ok := action.(*netlink.MirredAction)
+			if ok && mirredAction.Ifindex == destination.Attrs().Index {
+				return true
+			}
+		}
+	}
+
+	return false
+}
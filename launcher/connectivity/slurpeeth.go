@@ -3,6 +3,7 @@
 package connectivity
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"time"
@@ -85,18 +86,28 @@ func (m *slurpeethManager) Run() {
 
 	m.logger.Debug("initial slurpeeth tunnel creation complete")
 
+	m.updateImpairments(m.initialTunnels)
+	m.updateLinkStates(m.initialTunnels)
+
 	m.logger.Debug("start connectivity custom resource watch...")
 
-	go watchConnectivity(
-		m.ctx,
-		m.logger,
-		m.clabernetesClient,
-		m.renderSlurpeethConfig,
-	)
+	go m.watchConnectivity(m.handleConnectivityUpdate, m.forgetAppliedState)
+
+	go m.runTunnelStatusReporter()
 
 	m.logger.Debug("slurpeeth connectivity setup complete")
 }
 
+func (m *slurpeethManager) handleConnectivityUpdate(
+	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	m.renderSlurpeethConfig(tunnels)
+	m.updateImpairments(tunnels)
+	m.updateLinkStates(tunnels)
+
+	return nil
+}
+
 func (m *slurpeethManager) renderSlurpeethConfig(
 	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
 ) {
@@ -129,6 +140,13 @@ func (m *slurpeethManager) renderSlurpeethConfig(
 		)
 	}
 
+	existingSlurpeethConfigYAML, err := os.ReadFile(slurpeethConfigPath)
+	if err == nil && bytes.Equal(existingSlurpeethConfigYAML, slurpeethConfigYAML) {
+		// connectivity cr updates that dont change the tunnels (status updates, impairments and so
+		// on) should not cause slurpeeth to reload its config
+		return
+	}
+
 	err = os.WriteFile(
 		slurpeethConfigPath,
 		slurpeethConfigYAML,
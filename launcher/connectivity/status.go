@@ -0,0 +1,213 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// sysClassNetPath is where the kernel exposes the state and statistics of network interfaces.
+	sysClassNetPath = "/sys/class/net"
+
+	// tunnelStatusReportInterval is the interval at which launchers report the state and counters
+	// of their tunnels even if nothing else changed.
+	tunnelStatusReportInterval = 30 * time.Second
+)
+
+// readInterfaceCounter returns the given statistics counter of the interface at the given sysfs
+// path, or zero if the counter cannot be read.
+func readInterfaceCounter(interfacePath, counter string) int64 {
+	content, err := os.ReadFile(filepath.Join(interfacePath, "statistics", counter))
+	if err != nil {
+		return 0
+	}
+
+	value, err := strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
+
+// tunnelStatus returns the status of the given tunnel -- the applied administrative state plus
+// the existence, operational state and counters of the (launcher side) local interface.
+func tunnelStatus(
+	tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+	state string,
+	now metav1.Time,
+) clabernetesapisv1alpha1.PointToPointTunnelStatus {
+	status := clabernetesapisv1alpha1.PointToPointTunnelStatus{
+		TunnelID:       tunnel.TunnelID,
+		LocalNode:      tunnel.LocalNode,
+		LocalInterface: tunnel.LocalInterface,
+		State:          state,
+		LastUpdateTime: now,
+	}
+
+	interfacePath := filepath.Join(
+		sysClassNetPath,
+		sanitizeInterfaceName(fmt.Sprintf("%s-%s", tunnel.LocalNode, tunnel.LocalInterface)),
+	)
+
+	operState, err := os.ReadFile(filepath.Join(interfacePath, "operstate"))
+	if err != nil {
+		// no interface, nothing more to report
+		return status
+	}
+
+	status.InterfaceExists = true
+	status.OperState = strings.TrimSpace(string(operState))
+	status.RxBytes = readInterfaceCounter(interfacePath, "rx_bytes")
+	status.RxPackets = readInterfaceCounter(interfacePath, "rx_packets")
+	status.TxBytes = readInterfaceCounter(interfacePath, "tx_bytes")
+	status.TxPackets = readInterfaceCounter(interfacePath, "tx_packets")
+
+	return status
+}
+
+// tunnelStatusesStateEqual returns true if the given tunnel statuses are equal when ignoring the
+// (ever changing) counters and update times.
+func tunnelStatusesStateEqual(a, b []clabernetesapisv1alpha1.PointToPointTunnelStatus) bool {
+	return slices.EqualFunc(
+		a,
+		b,
+		func(x, y clabernetesapisv1alpha1.PointToPointTunnelStatus) bool {
+			return x.TunnelID == y.TunnelID &&
+				x.LocalNode == y.LocalNode &&
+				x.LocalInterface == y.LocalInterface &&
+				x.State == y.State &&
+				x.InterfaceExists == y.InterfaceExists &&
+				x.OperState == y.OperState
+		},
+	)
+}
+
+// currentTunnelStatuses returns the current statuses of the reported tunnels, callers must hold
+// the status lock.
+func (c *common) currentTunnelStatuses() []clabernetesapisv1alpha1.PointToPointTunnelStatus {
+	now := metav1.Now()
+
+	tunnelStatuses := make(
+		[]clabernetesapisv1alpha1.PointToPointTunnelStatus,
+		0,
+		len(c.reportedTunnels),
+	)
+
+	for _, tunnel := range c.reportedTunnels {
+		state, ok := c.currentLinkStates[tunnelKey(tunnel)]
+		if !ok {
+			// setting the state failed, we'll report it once it has been applied
+			continue
+		}
+
+		tunnelStatuses = append(tunnelStatuses, tunnelStatus(tunnel, state, now))
+	}
+
+	return tunnelStatuses
+}
+
+// reportTunnelStatuses sets the given tunnels as the tunnels to report and writes their statuses
+// to the connectivity status if the state of any of them changed. Counters alone changing is not
+// reported here (that is left to the periodic reporting) so that the resulting connectivity
+// modification events do not cause an endless update loop between the launchers.
+func (c *common) reportTunnelStatuses(tunnels []*clabernetesapisv1alpha1.PointToPointTunnel) {
+	c.statusLock.Lock()
+
+	c.reportedTunnels = tunnels
+
+	tunnelStatuses := c.currentTunnelStatuses()
+
+	changed := c.lastTunnelStatuses == nil ||
+		!tunnelStatusesStateEqual(c.lastTunnelStatuses, tunnelStatuses)
+
+	c.statusLock.Unlock()
+
+	if !changed {
+		return
+	}
+
+	c.writeTunnelStatuses(tunnelStatuses)
+}
+
+// runTunnelStatusReporter periodically writes the statuses (and therefore the recent counters) of
+// the reported tunnels to the connectivity status until the launcher context is cancelled.
+func (c *common) runTunnelStatusReporter() {
+	ticker := time.NewTicker(tunnelStatusReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.statusLock.Lock()
+
+			tunnelStatuses := c.currentTunnelStatuses()
+
+			c.statusLock.Unlock()
+
+			if len(tunnelStatuses) == 0 {
+				continue
+			}
+
+			c.writeTunnelStatuses(tunnelStatuses)
+		}
+	}
+}
+
+// writeTunnelStatuses writes the given tunnel statuses to the status of the connectivity cr for
+// the node of this launcher. The statuses are written with a merge patch that only touches the
+// entry of this node so the launchers of a topology don't conflict with one another. Failing to
+// do so is not fatal, the statuses are simply written again on the next report.
+func (c *common) writeTunnelStatuses(
+	tunnelStatuses []clabernetesapisv1alpha1.PointToPointTunnelStatus,
+) {
+	patch, err := json.Marshal(
+		map[string]any{
+			"status": map[string]any{
+				"pointToPointTunnels": map[string]any{
+					os.Getenv(clabernetesconstants.LauncherNodeNameEnv): tunnelStatuses,
+				},
+			},
+		},
+	)
+	if err != nil {
+		c.logger.Warnf("failed marshaling tunnel statuses, error: %s", err)
+
+		return
+	}
+
+	_, err = c.clabernetesClient.ClabernetesV1alpha1().
+		Connectivities(os.Getenv(clabernetesconstants.PodNamespaceEnv)).
+		Patch(
+			c.ctx,
+			os.Getenv(clabernetesconstants.LauncherTopologyNameEnv),
+			apimachinerytypes.MergePatchType,
+			patch,
+			metav1.PatchOptions{},
+			"status",
+		)
+	if err != nil {
+		c.logger.Warnf("failed reporting tunnel statuses, error: %s", err)
+
+		return
+	}
+
+	c.statusLock.Lock()
+
+	c.lastTunnelStatuses = tunnelStatuses
+
+	c.statusLock.Unlock()
+}
@@ -58,26 +58,26 @@ func (m *vxlanManager) Run() {
 			)
 		}
 
-		// we store them in a nice little map by local interface name so they're easy to
+		// we store them in a nice little map by local node and interface name so they're easy to
 		// reconcile on connectivity cr updates
-		m.currentTunnels[tunnel.LocalInterface] = tunnel
+		m.currentTunnels[tunnelKey(tunnel)] = tunnel
 	}
 
 	m.logger.Debug("initial vxlan tunnel creation complete")
 
+	m.updateImpairments(m.initialTunnels)
+	m.updateLinkStates(m.initialTunnels)
+
 	m.logger.Debug("start connectivity custom resource watch...")
 
-	go watchConnectivity(
-		m.ctx,
-		m.logger,
-		m.clabernetesClient,
-		m.updateVxlanTunnels,
-	)
+	go m.watchConnectivity(m.handleConnectivityUpdate, m.forgetAppliedState)
+
+	go m.runTunnelStatusReporter()
 
 	m.logger.Debug("vxlan connectivity setup complete")
 }
 
-func (m *vxlanManager) resolveVXLANService(vxlanRemote string) (string, error) {
+func (c *common) resolveVXLANService(vxlanRemote string) (string, error) {
 	var resolvedVxlanRemotes []net.IP
 
 	var err error
@@ -85,7 +85,7 @@ func (m *vxlanManager) resolveVXLANService(vxlanRemote string) (string, error) {
 	for range resolveServiceMaxAttempts {
 		resolvedVxlanRemotes, err = net.LookupIP(vxlanRemote) //nolint: noctx
 		if err != nil {
-			m.logger.Warnf(
+			c.logger.Warnf(
 				"failed resolving remote vxlan endpoint but under max attempts will try"+
 					" again in %s. error: %s",
 				resolveServiceSleep,
@@ -199,6 +199,24 @@ func (m *vxlanManager) runContainerlabVxlanToolsDelete(
 	return nil
 }
 
+// forgetAppliedState forgets the tunnels created so far (on top of the common applied state) so
+// that all tunnels are re-created on the next update.
+func (m *vxlanManager) forgetAppliedState() {
+	m.common.forgetAppliedState()
+
+	m.currentTunnels = make(map[string]*clabernetesapisv1alpha1.PointToPointTunnel)
+}
+
+func (m *vxlanManager) handleConnectivityUpdate(
+	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	m.updateVxlanTunnels(tunnels)
+	m.updateImpairments(tunnels)
+	m.updateLinkStates(tunnels)
+
+	return nil
+}
+
 func (m *vxlanManager) updateVxlanTunnels(
 	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
 ) {
@@ -207,7 +225,7 @@ func (m *vxlanManager) updateVxlanTunnels(
 		var found bool
 
 		for _, tunnel := range tunnels {
-			if tunnel.LocalInterface == existingTunnel.LocalInterface {
+			if tunnelKey(tunnel) == tunnelKey(existingTunnel) {
 				found = true
 
 				break
@@ -234,16 +252,19 @@ func (m *vxlanManager) updateVxlanTunnels(
 				err,
 			)
 		}
+
+		delete(m.currentTunnels, tunnelKey(existingTunnel))
 	}
 
 	tunnelsToReCreate := make([]*clabernetesapisv1alpha1.PointToPointTunnel, 0)
 
 	for _, tunnel := range tunnels {
-		existingTunnel, ok := m.currentTunnels[tunnel.LocalInterface]
-		if ok && reflect.DeepEqual(existingTunnel, tunnel) {
+		existingTunnel, ok := m.currentTunnels[tunnelKey(tunnel)]
+		if ok &&
+			reflect.DeepEqual(withoutLiveSettings(existingTunnel), withoutLiveSettings(tunnel)) {
 			// we've already got a tunnel setup for this interface, so we gotta check to see if our
 			// previously setup destination is the same -- if "yes" we can skip doing anything to
-			// this one.
+			// this one. impairments and states are handled separately, so they are ignored here.
 			continue
 		}
 
@@ -284,5 +305,7 @@ func (m *vxlanManager) updateVxlanTunnels(
 				err,
 			)
 		}
+
+		m.currentTunnels[tunnelKey(tunnel)] = tunnel
 	}
 }
@@ -0,0 +1,102 @@
+//go:build linux
+
+package connectivity
+
+import (
+	"net"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	"github.com/vishvananda/netlink"
+)
+
+// vxlanNetlinkKind is the netlink tunnel kind for vxlan tunnels, the interfaces are named like the
+// ones containerlab creates ("vx-<local interface>").
+var vxlanNetlinkKind = &netlinkTunnelKind{ //nolint:gochecknoglobals
+	name:            "vxlan",
+	interfacePrefix: "vx-",
+	overhead:        50,
+	newLink: func(
+		attrs netlink.LinkAttrs,
+		tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+		remoteIP net.IP,
+		underlayInterface netlink.Link,
+	) netlink.Link {
+		return &netlink.Vxlan{
+			LinkAttrs:    attrs,
+			VxlanId:      tunnel.TunnelID,
+			VtepDevIndex: underlayInterface.Attrs().Index,
+			Group:        remoteIP,
+			Port:         clabernetesconstants.VXLANServicePort,
+			Learning:     true,
+		}
+	},
+	conforms: func(
+		existingLink netlink.Link,
+		tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+		remoteIP net.IP,
+	) bool {
+		existingVxlan, ok := existingLink.(*netlink.Vxlan)
+		if !ok {
+			return false
+		}
+
+		return existingVxlan.VxlanId == tunnel.TunnelID &&
+			existingVxlan.Group.Equal(remoteIP) &&
+			existingVxlan.Port == clabernetesconstants.VXLANServicePort
+	},
+}
+
+type vxlanNetlinkManager struct {
+	*common
+}
+
+func (m *vxlanNetlinkManager) Run() {
+	m.logger.Info(
+		"connectivity mode is 'vxlan-netlink', setting up any required tunnels...",
+	)
+
+	err := m.reconcileNetlinkTunnels(vxlanNetlinkKind, m.initialTunnels, m.resolveTunnelService)
+	if err != nil {
+		m.logger.Fatalf("failed setting up vxlan tunnels, error: %s", err)
+	}
+
+	m.logger.Debug("initial vxlan tunnel creation complete")
+
+	m.updateImpairments(m.initialTunnels)
+	m.updateLinkStates(m.initialTunnels)
+
+	m.logger.Debug("start connectivity custom resource watch...")
+
+	go m.watchConnectivity(m.handleConnectivityUpdate, m.forgetAppliedState)
+
+	go m.runTunnelStatusReporter()
+
+	m.logger.Debug("vxlan-netlink connectivity setup complete")
+}
+
+func (m *vxlanNetlinkManager) handleConnectivityUpdate(
+	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	err := m.reconcileNetlinkTunnels(vxlanNetlinkKind, tunnels, m.resolveTunnelService)
+	if err != nil {
+		return err
+	}
+
+	m.updateImpairments(tunnels)
+	m.updateLinkStates(tunnels)
+
+	return nil
+}
+
+// resolveTunnelService resolves the (fabric service) destination of the given tunnel.
+func (c *common) resolveTunnelService(
+	tunnel *clabernetesapisv1alpha1.PointToPointTunnel,
+) (net.IP, error) {
+	resolvedRemote, err := c.resolveVXLANService(tunnel.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	return net.ParseIP(resolvedRemote), nil
+}
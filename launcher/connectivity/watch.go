@@ -1,23 +1,30 @@
 package connectivity
 
 import (
-	"context"
 	"fmt"
 	"os"
+	"time"
 
 	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
 	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
-	clabernetesgeneratedclientset "github.com/srl-labs/clabernetes/generated/clientset"
 	claberneteslogging "github.com/srl-labs/clabernetes/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachinerywatch "k8s.io/apimachinery/pkg/watch"
 )
 
-func watchConnectivity(
-	ctx context.Context,
-	logger claberneteslogging.Instance,
-	clabernetesClient *clabernetesgeneratedclientset.Clientset,
-	handleUpdate func(nodeTunnels []*clabernetesapisv1alpha1.PointToPointTunnel),
+const (
+	// connectivityUpdateRetryInterval is the interval at which a failed connectivity update is
+	// retried.
+	connectivityUpdateRetryInterval = 15 * time.Second
+)
+
+// watchConnectivity watches the connectivity cr and passes the tunnels of this launcher to the
+// given handleUpdate func whenever they change. On Resync the given forgetApplied func is called
+// before the current tunnels are passed to handleUpdate again, so that handleUpdate re-applies
+// everything rather than just what changed.
+func (c *common) watchConnectivity(
+	handleUpdate func(nodeTunnels []*clabernetesapisv1alpha1.PointToPointTunnel) error,
+	forgetApplied func(),
 ) {
 	nodeName := os.Getenv(clabernetesconstants.LauncherNodeNameEnv)
 
@@ -28,44 +35,118 @@ func watchConnectivity(
 		Watch: true,
 	}
 
-	watch, err := clabernetesClient.ClabernetesV1alpha1().
+	watch, err := c.clabernetesClient.ClabernetesV1alpha1().
 		Connectivities(os.Getenv(clabernetesconstants.PodNamespaceEnv)).
-		Watch(ctx, listOptions)
+		Watch(c.ctx, listOptions)
 	if err != nil {
-		logger.Fatalf("failed watching clabernetes connectivity, err: %s", err)
+		c.logger.Fatalf("failed watching clabernetes connectivity, err: %s", err)
 	}
 
-	for event := range watch.ResultChan() {
-		switch event.Type {
-		case apimachinerywatch.Modified:
-			logger.Info("processing connectivity modification event")
+	// the generation of the connectivity cr only changes when its spec does -- the launchers
+	// periodically report their tunnel statuses, and we don't want to re-process the (unchanged)
+	// tunnels on every one of those status updates
+	var lastGeneration int64
 
-			tunnelsCR, ok := event.Object.(*clabernetesapisv1alpha1.Connectivity)
-			if !ok {
-				logger.Warn(
-					"failed casting event object to connectivity custom resource," +
-						" this is probably a bug",
-				)
+	// tunnels of a failed update are retried until they are applied or superseded by a newer
+	// update, a transient failure (i.e. resolving a remote service) should not kill the launcher
+	var (
+		pendingTunnels []*clabernetesapisv1alpha1.PointToPointTunnel
+		retry          <-chan time.Time
+	)
 
-				continue
-			}
+	// desiredTunnels are the tunnels of the latest update (applied or not), this is what a resync
+	// re-applies
+	desiredTunnels := c.initialTunnels
+
+	applyUpdate := func(nodeTunnels []*clabernetesapisv1alpha1.PointToPointTunnel) {
+		desiredTunnels = nodeTunnels
+
+		err := handleUpdate(nodeTunnels)
+		if err != nil {
+			c.logger.Warnf(
+				"failed processing connectivity update, will retry in %s, error: %s",
+				connectivityUpdateRetryInterval,
+				err,
+			)
+
+			pendingTunnels = nodeTunnels
+			retry = time.After(connectivityUpdateRetryInterval)
+
+			return
+		}
+
+		pendingTunnels = nil
+		retry = nil
+	}
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-retry:
+			c.logger.Info("retrying failed connectivity update")
+
+			applyUpdate(pendingTunnels)
+		case <-c.resync:
+			c.logger.Info("re-applying connectivity")
+
+			forgetApplied()
 
-			nodeTunnels, ok := tunnelsCR.Spec.PointToPointTunnels[nodeName]
+			applyUpdate(desiredTunnels)
+		case event, ok := <-watch.ResultChan():
 			if !ok {
-				logger.Warnf(
-					"no tunnels found for node %q, continuing but things may be broken",
-					nodeName,
-				)
+				return
 			}
 
-			handleUpdate(nodeTunnels)
-		case apimachinerywatch.Added,
-			apimachinerywatch.Deleted,
-			apimachinerywatch.Bookmark,
-			apimachinerywatch.Error:
+			handleConnectivityEvent(c.logger, nodeName, event, &lastGeneration, applyUpdate)
+		}
+	}
+}
+
+func handleConnectivityEvent(
+	logger claberneteslogging.Instance,
+	nodeName string,
+	event apimachinerywatch.Event,
+	lastGeneration *int64,
+	applyUpdate func(nodeTunnels []*clabernetesapisv1alpha1.PointToPointTunnel),
+) {
+	switch event.Type {
+	case apimachinerywatch.Modified:
+		tunnelsCR, ok := event.Object.(*clabernetesapisv1alpha1.Connectivity)
+		if !ok {
+			logger.Warn(
+				"failed casting event object to connectivity custom resource," +
+					" this is probably a bug",
+			)
+
+			return
+		}
+
+		if tunnelsCR.Generation == *lastGeneration {
+			logger.Debug("connectivity spec unchanged, ignoring modification event")
+
+			return
+		}
+
+		*lastGeneration = tunnelsCR.Generation
+
+		logger.Info("processing connectivity modification event")
+
+		nodeTunnels, ok := tunnelsCR.Spec.PointToPointTunnels[nodeName]
+		if !ok {
 			logger.Warnf(
-				"connectivity resource had %s event occur, ignoring...", event.Type,
+				"no tunnels found for node %q, continuing but things may be broken",
+				nodeName,
 			)
 		}
+
+		applyUpdate(nodeTunnels)
+	case apimachinerywatch.Added,
+		apimachinerywatch.Deleted,
+		apimachinerywatch.Bookmark,
+		apimachinerywatch.Error:
+		logger.Warnf(
+			"connectivity resource had %s event occur, ignoring...", event.Type,
+		)
 	}
 }
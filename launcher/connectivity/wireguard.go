@@ -0,0 +1,298 @@
+//go:build linux
+
+package connectivity
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/netip"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	// wireGuardInterfaceName is the name of the (single) wireguard interface of a launcher, all
+	// tunnels of the launcher are carried through this interface.
+	wireGuardInterfaceName = "wg-clab"
+
+	// wireGuardPersistentKeepalive is the persistent keepalive interval (in seconds) for the
+	// wireguard peers -- keeps the (service) nat mappings alive.
+	wireGuardPersistentKeepalive = 25
+)
+
+// wireGuardPeer is a remote launcher as seen by the wireguard interface of the local launcher.
+type wireGuardPeer struct {
+	endpoint   string
+	allowedIPs string
+}
+
+type wireGuardManager struct {
+	*common
+
+	currentPeers map[string]wireGuardPeer
+}
+
+func (m *wireGuardManager) Run() {
+	m.currentPeers = make(map[string]wireGuardPeer)
+
+	m.logger.Info(
+		"connectivity mode is 'wireguard', setting up any required tunnels...",
+	)
+
+	err := m.reconcileWireGuard(m.initialTunnels)
+	if err != nil {
+		m.logger.Fatalf("failed setting up wireguard tunnels, error: %s", err)
+	}
+
+	m.logger.Debug("initial wireguard tunnel creation complete")
+
+	m.updateImpairments(m.initialTunnels)
+	m.updateLinkStates(m.initialTunnels)
+
+	m.logger.Debug("start connectivity custom resource watch...")
+
+	go m.watchConnectivity(m.handleConnectivityUpdate, m.forgetAppliedState)
+
+	go m.runTunnelStatusReporter()
+
+	m.logger.Debug("wireguard connectivity setup complete")
+}
+
+func (m *wireGuardManager) handleConnectivityUpdate(
+	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	err := m.reconcileWireGuard(tunnels)
+	if err != nil {
+		return err
+	}
+
+	m.updateImpairments(tunnels)
+	m.updateLinkStates(tunnels)
+
+	return nil
+}
+
+// reconcileWireGuard sets up the wireguard interface and its peers for the given tunnels, and then
+// reconciles the vxlan tunnels that are carried through the wireguard interface.
+func (m *wireGuardManager) reconcileWireGuard(
+	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	if len(tunnels) > 0 {
+		err := m.ensureWireGuardInterface(tunnels)
+		if err != nil {
+			return err
+		}
+
+		err = m.reconcileWireGuardPeers(tunnels)
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.reconcileNetlinkTunnels(
+		vxlanNetlinkKind,
+		tunnels,
+		func(tunnel *clabernetesapisv1alpha1.PointToPointTunnel) (net.IP, error) {
+			if tunnel.WireGuard == nil {
+				return nil, fmt.Errorf(
+					"%w: tunnel has no wireguard information",
+					claberneteserrors.ErrConnectivity,
+				)
+			}
+
+			return net.ParseIP(tunnel.WireGuard.RemoteAddress), nil
+		},
+	)
+}
+
+// ensureWireGuardInterface creates the wireguard interface (if it does not exist yet), sets its
+// private key, listen port and address, and sets it up.
+func (m *wireGuardManager) ensureWireGuardInterface(
+	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	if tunnels[0].WireGuard == nil {
+		return fmt.Errorf(
+			"%w: tunnel has no wireguard information",
+			claberneteserrors.ErrConnectivity,
+		)
+	}
+
+	wireGuardInterface, err := netlink.LinkByName(wireGuardInterfaceName)
+	if err != nil {
+		var linkNotFoundErr netlink.LinkNotFoundError
+		if !errors.As(err, &linkNotFoundErr) {
+			return err
+		}
+
+		m.logger.Debugf("creating wireguard interface '%s'", wireGuardInterfaceName)
+
+		err = netlink.LinkAdd(&netlink.Wireguard{
+			LinkAttrs: netlink.LinkAttrs{Name: wireGuardInterfaceName},
+		})
+		if err != nil {
+			return fmt.Errorf(
+				"%w: failed creating wireguard interface: %w",
+				claberneteserrors.ErrConnectivity,
+				err,
+			)
+		}
+
+		wireGuardInterface, err = netlink.LinkByName(wireGuardInterfaceName)
+		if err != nil {
+			return err
+		}
+	}
+
+	// setting the (unchanged) private key and port again does not disturb any existing sessions,
+	// so we can just always do this rather than figuring out if the interface is already set up
+	err = m.runWGSet(
+		"listen-port",
+		strconv.Itoa(clabernetesconstants.WireGuardServicePort),
+		"private-key",
+		clabernetesconstants.WireGuardPrivateKeyPath,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed configuring wireguard interface: %w",
+			claberneteserrors.ErrConnectivity,
+			err,
+		)
+	}
+
+	network := netip.MustParsePrefix(clabernetesconstants.WireGuardNetwork)
+
+	address, err := netlink.ParseAddr(
+		fmt.Sprintf("%s/%d", tunnels[0].WireGuard.LocalAddress, network.Bits()),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = netlink.AddrReplace(wireGuardInterface, address)
+	if err != nil {
+		return fmt.Errorf(
+			"%w: failed setting wireguard interface address: %w",
+			claberneteserrors.ErrConnectivity,
+			err,
+		)
+	}
+
+	return netlink.LinkSetUp(wireGuardInterface)
+}
+
+// reconcileWireGuardPeers diffs the peers (remote launchers) of the given tunnels against the
+// currently configured peers and adds, updates or removes peers as necessary.
+func (m *wireGuardManager) reconcileWireGuardPeers(
+	tunnels []*clabernetesapisv1alpha1.PointToPointTunnel,
+) error {
+	desiredPeers := make(map[string]wireGuardPeer)
+
+	for _, tunnel := range tunnels {
+		if tunnel.WireGuard == nil {
+			return fmt.Errorf(
+				"%w: tunnel to remote node '%s' has no wireguard information",
+				claberneteserrors.ErrConnectivity,
+				tunnel.RemoteNode,
+			)
+		}
+
+		_, ok := desiredPeers[tunnel.WireGuard.RemotePublicKey]
+		if ok {
+			// multiple tunnels to the same remote launcher share the peer of course
+			continue
+		}
+
+		resolvedRemote, err := m.resolveVXLANService(tunnel.Destination)
+		if err != nil {
+			return err
+		}
+
+		desiredPeers[tunnel.WireGuard.RemotePublicKey] = wireGuardPeer{
+			endpoint: net.JoinHostPort(
+				resolvedRemote,
+				strconv.Itoa(clabernetesconstants.WireGuardServicePort),
+			),
+			allowedIPs: fmt.Sprintf("%s/32", tunnel.WireGuard.RemoteAddress),
+		}
+	}
+
+	for _, publicKey := range slices.Sorted(maps.Keys(m.currentPeers)) {
+		_, ok := desiredPeers[publicKey]
+		if ok {
+			continue
+		}
+
+		m.logger.Debugf("removing wireguard peer '%s'", publicKey)
+
+		err := m.runWGSet("peer", publicKey, "remove")
+		if err != nil {
+			return fmt.Errorf(
+				"%w: failed removing wireguard peer: %w",
+				claberneteserrors.ErrConnectivity,
+				err,
+			)
+		}
+
+		delete(m.currentPeers, publicKey)
+	}
+
+	for _, publicKey := range slices.Sorted(maps.Keys(desiredPeers)) {
+		peer := desiredPeers[publicKey]
+
+		currentPeer, ok := m.currentPeers[publicKey]
+		if ok && currentPeer == peer {
+			continue
+		}
+
+		m.logger.Debugf("setting wireguard peer '%s' with endpoint '%s'", publicKey, peer.endpoint)
+
+		err := m.runWGSet(
+			"peer",
+			publicKey,
+			"endpoint",
+			peer.endpoint,
+			"allowed-ips",
+			peer.allowedIPs,
+			"persistent-keepalive",
+			strconv.Itoa(wireGuardPersistentKeepalive),
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"%w: failed setting wireguard peer: %w",
+				claberneteserrors.ErrConnectivity,
+				err,
+			)
+		}
+
+		m.currentPeers[publicKey] = peer
+	}
+
+	return nil
+}
+
+func (m *wireGuardManager) runWGSet(args ...string) error {
+	cmd := exec.CommandContext( //nolint:gosec
+		m.ctx,
+		"wg",
+		append([]string{"set", wireGuardInterfaceName}, args...)...,
+	)
+
+	m.logger.Debugf(
+		"using following args for wireguard configuration '%s'",
+		strings.Join(cmd.Args, " "),
+	)
+
+	cmd.Stdout = m.logger
+	cmd.Stderr = m.logger
+
+	return cmd.Run()
+}
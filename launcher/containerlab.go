@@ -14,6 +14,7 @@ import (
 	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
 	claberneteserrors "github.com/srl-labs/clabernetes/errors"
 	clabernetesutil "github.com/srl-labs/clabernetes/util"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
 )
 
 func extractContainerlabBin(r io.Reader) error {
@@ -145,6 +146,8 @@ func (c *clabernetes) runContainerlab() error {
 		"topo.clab.yaml",
 	}
 
+	args = append(args, clabernetesutilcontainerlab.RuntimeArgs()...)
+
 	if !(os.Getenv(clabernetesconstants.LauncherContainerlabPersist) == clabernetesconstants.True) {
 		args = append(args, "--reconfigure")
 	}
@@ -170,3 +173,23 @@ func (c *clabernetes) runContainerlab() error {
 
 	return nil
 }
+
+// destroyContainerlab destroys the containerlab topology of the launcher. The lab directory is
+// kept, so a following runContainerlab behaves just like the initial deploy (that is, the node
+// configs are only regenerated if persistence is not enabled).
+func (c *clabernetes) destroyContainerlab() error {
+	args := []string{
+		"destroy",
+		"-t",
+		"topo.clab.yaml",
+	}
+
+	args = append(args, clabernetesutilcontainerlab.RuntimeArgs()...)
+
+	cmd := exec.CommandContext(c.ctx, "containerlab", args...) //nolint: gosec
+
+	cmd.Stdout = c.containerlabLogger
+	cmd.Stderr = c.containerlabLogger
+
+	return cmd.Run()
+}
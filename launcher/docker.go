@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -22,6 +23,18 @@ const (
 	overlayStorageDriver = "overlay2"
 )
 
+// containerRuntime returns the container runtime (cli) the launcher uses to manage the node
+// containers -- docker unless the podman launcher runtime was requested.
+func containerRuntime() string {
+	if os.Getenv(
+		clabernetesconstants.LauncherRuntimeEnv,
+	) == clabernetesconstants.LauncherRuntimePodman {
+		return clabernetesconstants.LauncherRuntimePodman
+	}
+
+	return clabernetesconstants.LauncherRuntimeDocker
+}
+
 func daemonConfigExists() bool {
 	_, err := os.Stat(dockerDaemonConfig)
 
@@ -150,7 +163,7 @@ func getContainerIDs(ctx context.Context, all bool) ([]string, error) {
 
 	args = append(args, "--quiet")
 
-	psCmd := exec.CommandContext(ctx, "docker", args...)
+	psCmd := exec.CommandContext(ctx, containerRuntime(), args...)
 
 	output, err := psCmd.Output()
 	if err != nil {
@@ -183,7 +196,7 @@ func printContainerLogs(
 			containerID,
 		}
 
-		cmd := exec.CommandContext(ctx, "docker", args...) //nolint:gosec
+		cmd := exec.CommandContext(ctx, containerRuntime(), args...) //nolint:gosec
 
 		cmd.Stdout = logger
 		cmd.Stderr = logger
@@ -218,7 +231,7 @@ func tailContainerLogs(
 				containerID,
 			}
 
-			cmd := exec.CommandContext(ctx, "docker", args...) //nolint:gosec
+			cmd := exec.CommandContext(ctx, containerRuntime(), args...) //nolint:gosec
 
 			cmd.Stdout = nodeOutWriter
 			cmd.Stderr = nodeOutWriter
@@ -238,7 +251,7 @@ func tailContainerLogs(
 func getContainerIDForNodeName(ctx context.Context, nodeName string) (string, error) {
 	psCmd := exec.CommandContext( //nolint:gosec
 		ctx,
-		"docker",
+		containerRuntime(),
 		"ps",
 		"--quiet",
 		"--filter",
@@ -256,7 +269,7 @@ func getContainerIDForNodeName(ctx context.Context, nodeName string) (string, er
 func getContainerAddr(ctx context.Context, containerID string) (string, error) {
 	inspectCmd := exec.CommandContext( //nolint: gosec
 		ctx,
-		"docker",
+		containerRuntime(),
 		"inspect",
 		"--format",
 		"{{range.NetworkSettings.Networks}}{{.IPAddress}}{{end}}",
@@ -270,3 +283,33 @@ func getContainerAddr(ctx context.Context, containerID string) (string, error) {
 
 	return strings.TrimSpace(string(output)), nil
 }
+
+// execInContainer executes the given command in the given container, returning the combined
+// stdout and stderr output of the command.
+func execInContainer(ctx context.Context, containerID string, command []string) ([]byte, error) {
+	execCmd := exec.CommandContext( //nolint: gosec
+		ctx,
+		containerRuntime(),
+		append([]string{"exec", containerID}, command...)...,
+	)
+
+	return execCmd.CombinedOutput()
+}
+
+func getContainerRestartCount(ctx context.Context, containerID string) (int, error) {
+	inspectCmd := exec.CommandContext( //nolint: gosec
+		ctx,
+		containerRuntime(),
+		"inspect",
+		"--format",
+		"{{.RestartCount}}",
+		containerID,
+	)
+
+	output, err := inspectCmd.Output()
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(string(output)))
+}
@@ -15,6 +15,7 @@ import (
 	clabernetesutilkubernetes "github.com/srl-labs/clabernetes/util/kubernetes"
 	"gopkg.in/yaml.v3"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachinerymeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -24,13 +25,6 @@ const (
 	imageCheckLogCounter   = 6
 )
 
-func generateImageRequestCRName(nodeName, imageName string) string {
-	// hash the image name so it doesn't contain invalid chars for k8s name
-	return clabernetesutilkubernetes.SafeConcatNameKubernetes(
-		nodeName, clabernetesutil.HashBytes([]byte(imageName)),
-	)
-}
-
 func (c *clabernetes) image() {
 	abort, imageManager := c.prepareImagePullThrough()
 	if abort {
@@ -53,7 +47,7 @@ func (c *clabernetes) image() {
 	}
 
 	if imagePresent {
-		c.logger.Infof("image %q is present, begin copy to docker daemon...", c.imageName)
+		c.logger.Infof("image %q is present, begin copy to container runtime...", c.imageName)
 
 		c.copyImageFromCRI(imageManager)
 
@@ -91,16 +85,34 @@ func (c *clabernetes) image() {
 }
 
 func (c *clabernetes) copyImageFromCRI(imageManager claberneteslauncherimage.Manager) {
-	err := imageManager.Export(c.ctx, c.imageName, imageDestination)
-	if err != nil {
-		c.logger.Warnf("failed image pull through (export), err: %s", err)
+	imageSource := imageDestination
 
-		handleImagePullThroughModeAlwaysPanic(c.imagePullThroughMode)
+	var err error
 
-		return
+	if imageCacheEnabled() {
+		imageSource, err = c.exportImageToCache(imageManager)
+		if err != nil {
+			c.logger.Warnf(
+				"failed using node image cache, will export image directly, err: %s",
+				err,
+			)
+
+			imageSource = imageDestination
+		}
+	}
+
+	if imageSource == imageDestination {
+		err = imageManager.Export(c.ctx, c.imageName, imageDestination)
+		if err != nil {
+			c.logger.Warnf("failed image pull through (export), err: %s", err)
+
+			handleImagePullThroughModeAlwaysPanic(c.imagePullThroughMode)
+
+			return
+		}
 	}
 
-	err = c.imageImport()
+	err = c.imageImport(imageSource)
 	if err != nil {
 		c.logger.Warnf("failed image pull through (import), err: %s", err)
 
@@ -190,7 +202,7 @@ func (c *clabernetes) requestImagePull(
 ) error {
 	nodeName := os.Getenv(clabernetesconstants.NodeNameEnv)
 
-	imageRequestCRName := generateImageRequestCRName(nodeName, c.imageName)
+	imageRequestCRName := clabernetesutilkubernetes.ImageRequestName(nodeName, c.imageName)
 
 	err := c.createImageRequestCR(nodeName, imageRequestCRName, configuredPullSecrets)
 	if err != nil {
@@ -206,7 +218,7 @@ func (c *clabernetes) requestImagePull(
 		return err
 	}
 
-	err = c.waitForImage(imageManager)
+	err = c.waitForImage(imageManager, imageRequestCRName)
 	if err != nil {
 		c.logger.Warnf("failed image pull through (wait image present), err: %s", err)
 
@@ -245,8 +257,14 @@ func (c *clabernetes) createImageRequestCR(
 		)
 	if err != nil {
 		if apimachineryerrors.IsAlreadyExists(err) {
-			// if it already exists some other launcher has requested this image for this node
-			return nil
+			// if it already exists some other launcher has requested this image for this node --
+			// unless that request already failed, in that case we want to try again rather than
+			// fail with the outcome of some earlier attempt
+			return c.replaceFailedImageRequestCR(
+				nodeName,
+				imageRequestCRName,
+				configuredPullSecrets,
+			)
 		}
 
 		// any other error would be a bad bingo
@@ -256,6 +274,74 @@ func (c *clabernetes) createImageRequestCR(
 	return nil
 }
 
+func (c *clabernetes) replaceFailedImageRequestCR(
+	nodeName, imageRequestCRName string,
+	configuredPullSecrets []string,
+) error {
+	imageRequestCR, err := c.getImageRequestCR(imageRequestCRName)
+	if err != nil {
+		return err
+	}
+
+	if imageRequestFailure(imageRequestCR) == nil {
+		return nil
+	}
+
+	c.logger.Infof(
+		"image request cr %q previously failed, deleting and re-requesting image...",
+		imageRequestCRName,
+	)
+
+	ctx, cancel := context.WithTimeout(c.ctx, clientDefaultTimeout)
+	defer cancel()
+
+	err = c.kubeClabernetesClient.ClabernetesV1alpha1().
+		ImageRequests(os.Getenv(clabernetesconstants.PodNamespaceEnv)).
+		Delete(ctx, imageRequestCRName, metav1.DeleteOptions{})
+	if err != nil && !apimachineryerrors.IsNotFound(err) {
+		return err
+	}
+
+	return c.createImageRequestCR(nodeName, imageRequestCRName, configuredPullSecrets)
+}
+
+func (c *clabernetes) getImageRequestCR(
+	imageRequestCRName string,
+) (*clabernetesapisv1alpha1.ImageRequest, error) {
+	ctx, cancel := context.WithTimeout(c.ctx, clientDefaultTimeout)
+	defer cancel()
+
+	return c.kubeClabernetesClient.ClabernetesV1alpha1().
+		ImageRequests(os.Getenv(clabernetesconstants.PodNamespaceEnv)).
+		Get(
+			ctx,
+			imageRequestCRName,
+			metav1.GetOptions{},
+		)
+}
+
+// imageRequestFailure returns an error describing why the image request failed if the controller
+// gave up on pulling the image, otherwise nil.
+func imageRequestFailure(imageRequestCR *clabernetesapisv1alpha1.ImageRequest) error {
+	if !apimachinerymeta.IsStatusConditionFalse(
+		imageRequestCR.Status.Conditions,
+		clabernetesconstants.ImageRequestPulledCondition,
+	) {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%w: image request %q failed after %d attempt(s), reason %q: %s -- check that the image"+
+			" name is correct and exists, and that pull secrets for its registry are configured"+
+			" (imagePull.pullSecrets) if it is private",
+		claberneteserrors.ErrLaunch,
+		imageRequestCR.Spec.RequestedImage,
+		imageRequestCR.Status.Attempts,
+		imageRequestCR.Status.FailureReason,
+		imageRequestCR.Status.FailureMessage,
+	)
+}
+
 func (c *clabernetes) waitImageRequestCRAccepted(imageRequestCRName string) error {
 	startTime := time.Now()
 
@@ -266,18 +352,7 @@ func (c *clabernetes) waitImageRequestCRAccepted(imageRequestCRName string) erro
 			break
 		}
 
-		ctx, cancel := context.WithTimeout(c.ctx, clientDefaultTimeout)
-
-		imageRequestCR, err := c.kubeClabernetesClient.ClabernetesV1alpha1().
-			ImageRequests(os.Getenv(clabernetesconstants.PodNamespaceEnv)).
-			Get(
-				ctx,
-				imageRequestCRName,
-				metav1.GetOptions{},
-			)
-
-		cancel()
-
+		imageRequestCR, err := c.getImageRequestCR(imageRequestCRName)
 		if err != nil {
 			return err
 		}
@@ -298,6 +373,7 @@ func (c *clabernetes) waitImageRequestCRAccepted(imageRequestCRName string) erro
 
 func (c *clabernetes) waitForImage(
 	imageManager claberneteslauncherimage.Manager,
+	imageRequestCRName string,
 ) error {
 	startTime := time.Now()
 
@@ -306,7 +382,7 @@ func (c *clabernetes) waitForImage(
 	var checkCounter int
 
 	for range ticker.C {
-		if time.Since(startTime) > clabernetesconstants.PullerPodTimeout {
+		if time.Since(startTime) > clabernetesconstants.ImageRequestPullTimeout {
 			break
 		}
 
@@ -321,6 +397,16 @@ func (c *clabernetes) waitForImage(
 			return nil
 		}
 
+		// no point in waiting out the timeout if the controller already gave up on the pull
+		imageRequestCR, err := c.getImageRequestCR(imageRequestCRName)
+		if err != nil {
+			if !apimachineryerrors.IsNotFound(err) {
+				c.logger.Warnf("failed fetching image request cr, will retry, err: %s", err)
+			}
+		} else if err = imageRequestFailure(imageRequestCR); err != nil {
+			return err
+		}
+
 		checkCounter++
 
 		if checkCounter == imageCheckLogCounter {
@@ -337,14 +423,14 @@ func (c *clabernetes) waitForImage(
 	)
 }
 
-func (c *clabernetes) imageImport() error {
-	exportCmd := exec.CommandContext(
+func (c *clabernetes) imageImport(source string) error {
+	exportCmd := exec.CommandContext( //nolint:gosec
 		c.ctx,
-		"docker",
+		containerRuntime(),
 		"image",
 		"load",
 		"-i",
-		"/clabernetes/.image/node-image.tar",
+		source,
 	)
 
 	exportCmd.Stdout = c.logger
@@ -359,11 +445,11 @@ func (c *clabernetes) imageImport() error {
 }
 
 func (c *clabernetes) imageCleanup() {
-	c.logger.Debug("running image (docker) cleanup in background...")
+	c.logger.Debug("running image (container runtime) cleanup in background...")
 
 	exportCmd := exec.CommandContext(
 		c.ctx,
-		"docker",
+		containerRuntime(),
 		"system",
 		"prune",
 		"--force",
@@ -374,6 +460,8 @@ func (c *clabernetes) imageCleanup() {
 
 	err := exportCmd.Run()
 	if err != nil {
-		c.logger.Warnf("failed pruning docker daemon, error: %s", err)
+		c.logger.Warnf("failed pruning container runtime images, error: %s", err)
 	}
+
+	c.imageCacheCleanup()
 }
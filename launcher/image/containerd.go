@@ -86,6 +86,31 @@ func (m *containerdManager) Export(ctx context.Context, imageName, destination s
 	return nil
 }
 
+func (m *containerdManager) ID(ctx context.Context, imageName string) (string, error) {
+	inspectCmd := exec.CommandContext( //nolint:gosec
+		ctx,
+		"nerdctl",
+		"--address",
+		"/clabernetes/.node/containerd.sock",
+		"--namespace",
+		"k8s.io",
+		"image",
+		"inspect",
+		"--platform",
+		nativePlatform(),
+		"--format",
+		"{{.ID}}",
+		imageName,
+	)
+
+	output, err := inspectCmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return parseImageID(output, imageName)
+}
+
 func (m *containerdManager) pull(ctx context.Context, imageName string) error {
 	pullCmd := exec.CommandContext( //nolint: gosec
 		ctx,
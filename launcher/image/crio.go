@@ -0,0 +1,108 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+)
+
+// crioManager is the image manager for cri-o nodes -- cri-o has no api to export images (and
+// there is no cri-o equivalent to nerdctl), so instead we (read only) mount the node's
+// containers/storage directory in the launcher and let podman, which shares the storage library
+// with cri-o, use it as an additional image store.
+type crioManager struct {
+	logger claberneteslogging.Instance
+}
+
+func crioPodmanArgs(args ...string) []string {
+	return append(
+		[]string{
+			"--storage-opt",
+			fmt.Sprintf(
+				"additionalimagestore=%s/%s",
+				clabernetesconstants.LauncherCRISockPath,
+				clabernetesconstants.KubernetesCRIStorageCrio,
+			),
+		},
+		args...,
+	)
+}
+
+func (m *crioManager) Present(ctx context.Context, imageName string) (bool, error) {
+	checkCmd := exec.CommandContext( //nolint:gosec
+		ctx,
+		"podman",
+		crioPodmanArgs(
+			"image",
+			"exists",
+			imageName,
+		)...,
+	)
+
+	err := checkCmd.Run()
+	if err != nil {
+		var exitErr *exec.ExitError
+
+		// podman image exists exits 1 when the image does not exist, anything else is a failure
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *crioManager) Export(ctx context.Context, imageName, destination string) error {
+	exportCmd := exec.CommandContext( //nolint: gosec
+		ctx,
+		"podman",
+		crioPodmanArgs(
+			"image",
+			"save",
+			"--format",
+			"docker-archive",
+			"--output",
+			destination,
+			imageName,
+		)...,
+	)
+
+	exportCmd.Stdout = m.logger
+	exportCmd.Stderr = m.logger
+
+	err := exportCmd.Run()
+	if err != nil {
+		return err
+	}
+
+	m.logger.Debugf("image %q exported from cri-o storage successfully...", imageName)
+
+	return nil
+}
+
+func (m *crioManager) ID(ctx context.Context, imageName string) (string, error) {
+	inspectCmd := exec.CommandContext( //nolint:gosec
+		ctx,
+		"podman",
+		crioPodmanArgs(
+			"image",
+			"inspect",
+			"--format",
+			"{{.Id}}",
+			imageName,
+		)...,
+	)
+
+	output, err := inspectCmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return parseImageID(output, imageName)
+}
@@ -0,0 +1,96 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+)
+
+// dockerManager is the image manager for docker (dockershim/cri-dockerd) nodes, it talks to the
+// node's docker daemon via the mounted docker sock.
+type dockerManager struct {
+	logger claberneteslogging.Instance
+}
+
+func nodeDockerHost() string {
+	return fmt.Sprintf(
+		"unix://%s/%s",
+		clabernetesconstants.LauncherCRISockPath,
+		clabernetesconstants.KubernetesCRISockDocker,
+	)
+}
+
+func (m *dockerManager) Present(ctx context.Context, imageName string) (bool, error) {
+	checkCmd := exec.CommandContext( //nolint:gosec
+		ctx,
+		"docker",
+		"--host",
+		nodeDockerHost(),
+		"image",
+		"list",
+		"--filter",
+		fmt.Sprintf("reference=%s", imageName),
+		"--quiet",
+	)
+
+	output, err := checkCmd.Output()
+	if err != nil {
+		return false, err
+	}
+
+	if len(output) == 0 {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (m *dockerManager) Export(ctx context.Context, imageName, destination string) error {
+	exportCmd := exec.CommandContext( //nolint: gosec
+		ctx,
+		"docker",
+		"--host",
+		nodeDockerHost(),
+		"image",
+		"save",
+		"--output",
+		destination,
+		imageName,
+	)
+
+	exportCmd.Stdout = m.logger
+	exportCmd.Stderr = m.logger
+
+	err := exportCmd.Run()
+	if err != nil {
+		return err
+	}
+
+	m.logger.Debugf("image %q exported from node docker daemon successfully...", imageName)
+
+	return nil
+}
+
+func (m *dockerManager) ID(ctx context.Context, imageName string) (string, error) {
+	inspectCmd := exec.CommandContext( //nolint:gosec
+		ctx,
+		"docker",
+		"--host",
+		nodeDockerHost(),
+		"image",
+		"inspect",
+		"--format",
+		"{{.Id}}",
+		imageName,
+	)
+
+	output, err := inspectCmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return parseImageID(output, imageName)
+}
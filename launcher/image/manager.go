@@ -3,6 +3,7 @@ package image
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
 	claberneteserrors "github.com/srl-labs/clabernetes/errors"
@@ -18,6 +19,22 @@ type Manager interface {
 	// Export is the main reason we are using this and not the cri interface directly (cri has no
 	// service for export!) -- and does what it says: exports an image to disk.
 	Export(ctx context.Context, imageName, destination string) error
+	// ID returns the id (config digest) of the image on the node, this is used to key images in
+	// the node image cache.
+	ID(ctx context.Context, imageName string) (string, error)
+}
+
+func parseImageID(output []byte, imageName string) (string, error) {
+	imageID := strings.TrimSpace(string(output))
+	if imageID == "" {
+		return "", fmt.Errorf(
+			"%w: empty image id for image %q",
+			claberneteserrors.ErrLaunch,
+			imageName,
+		)
+	}
+
+	return imageID, nil
 }
 
 // NewManager returns an image Manager for the given cri.
@@ -27,6 +44,14 @@ func NewManager(logger claberneteslogging.Instance, criKind string) (Manager, er
 		return &containerdManager{
 			logger: logger,
 		}, nil
+	case clabernetesconstants.KubernetesCRICrio:
+		return &crioManager{
+			logger: logger,
+		}, nil
+	case clabernetesconstants.KubernetesCRIDocker:
+		return &dockerManager{
+			logger: logger,
+		}, nil
 	default:
 		return nil, fmt.Errorf(
 			"%w: unknown criKind, cannot create image manager",
@@ -0,0 +1,55 @@
+package image_test
+
+import (
+	"testing"
+
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteslauncherimage "github.com/srl-labs/clabernetes/launcher/image"
+	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+)
+
+func TestNewManager(t *testing.T) {
+	cases := []struct {
+		name      string
+		criKind   string
+		expectErr bool
+	}{
+		{
+			name:      "containerd",
+			criKind:   clabernetesconstants.KubernetesCRIContainerd,
+			expectErr: false,
+		},
+		{
+			name:      "crio",
+			criKind:   clabernetesconstants.KubernetesCRICrio,
+			expectErr: false,
+		},
+		{
+			name:      "docker",
+			criKind:   clabernetesconstants.KubernetesCRIDocker,
+			expectErr: false,
+		},
+		{
+			name:      "unknown",
+			criKind:   clabernetesconstants.KubernetesCRIUnknown,
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				_, err := claberneteslauncherimage.NewManager(
+					&claberneteslogging.FakeInstance{},
+					testCase.criKind,
+				)
+				if (err != nil) != testCase.expectErr {
+					t.Fatalf("expected error %t, got error: %v", testCase.expectErr, err)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,160 @@
+package launcher
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteslauncherimage "github.com/srl-labs/clabernetes/launcher/image"
+)
+
+const (
+	imageCacheMaxAge     = 7 * 24 * time.Hour
+	imageCacheLockSuffix = ".lock"
+	imageCacheTempSuffix = ".tmp"
+)
+
+func imageCacheEnabled() bool {
+	return os.Getenv(clabernetesconstants.LauncherImageCacheEnv) == clabernetesconstants.True
+}
+
+// imageCachePath returns the path of the cached image tarball for the given image id -- ids are
+// digests like "sha256:abc..." so we swap the separator to end up with a sane file name.
+func imageCachePath(cacheDir, imageID string) string {
+	return filepath.Join(
+		cacheDir,
+		strings.NewReplacer(":", "-", "/", "-").Replace(imageID)+".tar",
+	)
+}
+
+// lockImageCacheEntry takes an exclusive lock for the given cache entry, the cache directory is
+// shared by all launchers on the kubernetes node so this is a file lock rather than a mutex. The
+// returned func releases the lock.
+func lockImageCacheEntry(cachedImage string) (func(), error) {
+	lockFile, err := os.OpenFile(
+		cachedImage+imageCacheLockSuffix,
+		os.O_CREATE|os.O_RDWR,
+		clabernetesconstants.PermissionsEveryoneReadWrite,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		_ = lockFile.Close()
+
+		return nil, err
+	}
+
+	return func() {
+		_ = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+		_ = lockFile.Close()
+	}, nil
+}
+
+// exportImageToCache makes sure the node image is present in the node image cache and returns the
+// path of the cached tarball. Only the first launcher on a kubernetes node actually exports the
+// image from the cri, any other launcher waits on the lock and then reuses that export.
+func (c *clabernetes) exportImageToCache(
+	imageManager claberneteslauncherimage.Manager,
+) (string, error) {
+	imageID, err := imageManager.ID(c.ctx, c.imageName)
+	if err != nil {
+		return "", err
+	}
+
+	cachedImage := imageCachePath(clabernetesconstants.LauncherImageCachePath, imageID)
+
+	unlock, err := lockImageCacheEntry(cachedImage)
+	if err != nil {
+		return "", err
+	}
+
+	defer unlock()
+
+	_, err = os.Stat(cachedImage)
+	if err == nil {
+		c.logger.Infof("image %q found in node image cache, skipping export...", c.imageName)
+
+		// bump the modification time so the image is not evicted while it is still in use
+		now := time.Now()
+
+		err = os.Chtimes(cachedImage, now, now)
+		if err != nil {
+			c.logger.Warnf("failed updating node image cache entry times, err: %s", err)
+		}
+
+		return cachedImage, nil
+	}
+
+	c.logger.Infof("image %q not in node image cache, exporting...", c.imageName)
+
+	// export to a temporary file first so a failed/partial export is never seen as cached
+	err = imageManager.Export(c.ctx, c.imageName, cachedImage+imageCacheTempSuffix)
+	if err != nil {
+		_ = os.Remove(cachedImage + imageCacheTempSuffix)
+
+		return "", err
+	}
+
+	err = os.Rename(cachedImage+imageCacheTempSuffix, cachedImage)
+	if err != nil {
+		return "", err
+	}
+
+	return cachedImage, nil
+}
+
+// evictImageCache removes cached images that have not been used for longer than maxAge from the
+// given cache directory, returning the paths of the evicted images.
+func evictImageCache(cacheDir string, maxAge time.Duration) ([]string, error) {
+	cachedImages, err := filepath.Glob(filepath.Join(cacheDir, "*.tar"))
+	if err != nil {
+		return nil, err
+	}
+
+	evicted := make([]string, 0)
+
+	for _, cachedImage := range cachedImages {
+		unlock, err := lockImageCacheEntry(cachedImage)
+		if err != nil {
+			return evicted, err
+		}
+
+		// stat while holding the lock -- another launcher may have just used this entry
+		info, err := os.Stat(cachedImage)
+		if err == nil && time.Since(info.ModTime()) > maxAge {
+			err = os.Remove(cachedImage)
+			if err == nil {
+				evicted = append(evicted, cachedImage)
+			}
+		}
+
+		unlock()
+
+		if err != nil && !os.IsNotExist(err) {
+			return evicted, err
+		}
+	}
+
+	return evicted, nil
+}
+
+func (c *clabernetes) imageCacheCleanup() {
+	if !imageCacheEnabled() {
+		return
+	}
+
+	evicted, err := evictImageCache(clabernetesconstants.LauncherImageCachePath, imageCacheMaxAge)
+	if err != nil {
+		c.logger.Warnf("failed evicting unused node image cache entries, error: %s", err)
+	}
+
+	for _, cachedImage := range evicted {
+		c.logger.Debugf("evicted unused node image cache entry %q", cachedImage)
+	}
+}
@@ -0,0 +1,54 @@
+package launcher //nolint:testpackage // tests cover unexported image cache helpers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestImageCachePath(t *testing.T) {
+	t.Parallel()
+
+	got := imageCachePath("/cache", "sha256:abc123")
+	if got != "/cache/sha256-abc123.tar" {
+		t.Fatalf("expected %q, got %q", "/cache/sha256-abc123.tar", got)
+	}
+}
+
+func TestEvictImageCache(t *testing.T) {
+	t.Parallel()
+
+	cacheDir := t.TempDir()
+
+	staleImage := imageCachePath(cacheDir, "sha256:stale")
+	freshImage := imageCachePath(cacheDir, "sha256:fresh")
+
+	for _, cachedImage := range []string{staleImage, freshImage} {
+		err := os.WriteFile(cachedImage, []byte("image"), 0o600)
+		if err != nil {
+			t.Fatalf("failed writing cache entry, err: %s", err)
+		}
+	}
+
+	staleTime := time.Now().Add(-2 * imageCacheMaxAge)
+
+	err := os.Chtimes(staleImage, staleTime, staleTime)
+	if err != nil {
+		t.Fatalf("failed setting cache entry times, err: %s", err)
+	}
+
+	evicted, err := evictImageCache(cacheDir, imageCacheMaxAge)
+	if err != nil {
+		t.Fatalf("failed evicting image cache, err: %s", err)
+	}
+
+	if len(evicted) != 1 || evicted[0] != staleImage {
+		t.Fatalf("expected only %q to be evicted, got %q", staleImage, evicted)
+	}
+
+	_, err = os.Stat(freshImage)
+	if err != nil {
+		t.Fatalf("expected %q to be kept, err: %s", filepath.Base(freshImage), err)
+	}
+}
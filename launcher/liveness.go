@@ -0,0 +1,214 @@
+package launcher
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+)
+
+// livenessTracker tracks the node liveness for the liveness restart policies that are handled by
+// the launcher itself ("never" and "node") -- the "launcher" policy is simply a k8s liveness probe
+// so there is nothing for us to do in that case.
+type livenessTracker struct {
+	policy    string
+	threshold int
+
+	// started is set once the node passes its checks after (re)starting, failures are only
+	// counted after that as the startup probe is responsible for the node while it boots
+	started             bool
+	consecutiveFailures int
+	status              clabernetesapisv1alpha1.NodeProbeStatus
+	restarts            int
+}
+
+func (c *clabernetes) newLivenessTracker() *livenessTracker {
+	threshold := clabernetesutil.GetEnvIntOrDefault(
+		clabernetesconstants.LauncherLivenessFailureThreshold,
+		0,
+	)
+
+	policy := os.Getenv(clabernetesconstants.LauncherLivenessRestartPolicy)
+
+	if threshold == 0 || (policy != clabernetesconstants.LivenessRestartPolicyNever &&
+		policy != clabernetesconstants.LivenessRestartPolicyNode) {
+		return nil
+	}
+
+	c.logger.Debugf(
+		"will track node liveness with failure threshold %d and restart policy %q",
+		threshold,
+		policy,
+	)
+
+	tracker := &livenessTracker{
+		policy:    policy,
+		threshold: threshold,
+		status:    clabernetesapisv1alpha1.NodeProbeStatusUnknown,
+	}
+
+	// the launcher container itself may have been restarted, in that case we want to continue
+	// counting from the node restarts we reported before rather than start over
+	pod, err := c.kubeClient.CoreV1().
+		Pods(os.Getenv(clabernetesconstants.PodNamespaceEnv)).
+		Get(c.ctx, os.Getenv(clabernetesconstants.PodNameEnv), metav1.GetOptions{})
+	if err != nil {
+		c.logger.Warnf("failed fetching launcher pod, node restarts start at 0, err: %s", err)
+	} else {
+		tracker.restarts, _ = strconv.Atoi(
+			pod.Annotations[clabernetesconstants.AnnotationNodeRestarts],
+		)
+	}
+
+	c.reportLiveness(tracker)
+
+	return tracker
+}
+
+// handleLiveness updates the given tracker with the result of a probe round and applies the
+// restart policy if the node failed too many consecutive checks. It returns true if the node was
+// redeployed.
+func (c *clabernetes) handleLiveness(tracker *livenessTracker, healthy bool) bool {
+	if healthy {
+		tracker.started = true
+		tracker.consecutiveFailures = 0
+
+		if tracker.status != clabernetesapisv1alpha1.NodeProbeStatusPassing {
+			tracker.status = clabernetesapisv1alpha1.NodeProbeStatusPassing
+
+			c.reportLiveness(tracker)
+		}
+
+		return false
+	}
+
+	if !tracker.started {
+		return false
+	}
+
+	tracker.consecutiveFailures++
+
+	if tracker.consecutiveFailures < tracker.threshold {
+		return false
+	}
+
+	if tracker.status != clabernetesapisv1alpha1.NodeProbeStatusFailing {
+		c.logger.Warnf(
+			"node %q failed %d consecutive liveness checks",
+			c.nodeName,
+			tracker.consecutiveFailures,
+		)
+
+		tracker.status = clabernetesapisv1alpha1.NodeProbeStatusFailing
+
+		c.reportLiveness(tracker)
+	}
+
+	if tracker.policy != clabernetesconstants.LivenessRestartPolicyNode {
+		return false
+	}
+
+	c.logger.Warnf("redeploying node %q due to failing liveness checks", c.nodeName)
+
+	err := c.redeployNodes()
+	if err != nil {
+		// the nodes may well be gone at this point, nothing left to do but to have the launcher
+		// restarted
+		c.logger.Criticalf(
+			"failed redeploying node %q, sending done signal, err: %s",
+			c.nodeName,
+			err,
+		)
+
+		c.cancel()
+
+		return false
+	}
+
+	tracker.restarts++
+	tracker.started = false
+	tracker.consecutiveFailures = 0
+
+	c.reportLiveness(tracker)
+
+	return true
+}
+
+// redeployNodes redeploys the containerlab topology of the launcher -- that is the node and, for
+// node groups, the other members co-located in the launcher -- and then has the connectivity
+// manager re-apply the tunnels, as the redeploy replaces the interfaces they were attached to.
+// Just restarting the node container would lose the wiring containerlab set up.
+func (c *clabernetes) redeployNodes() error {
+	if os.Getenv(
+		clabernetesconstants.LauncherConnectivityKind,
+	) == clabernetesconstants.ConnectivitySlurpeeth {
+		// slurpeeth workers cannot re-bind to the interfaces of redeployed nodes, the launcher has
+		// to be restarted as a whole instead
+		return fmt.Errorf(
+			"%w: slurpeeth connectivity cannot be re-applied to redeployed nodes",
+			claberneteserrors.ErrLaunch,
+		)
+	}
+
+	c.redeployLock.Lock()
+	defer c.redeployLock.Unlock()
+
+	err := c.destroyContainerlab()
+	if err != nil {
+		return err
+	}
+
+	err = c.runContainerlab()
+	if err != nil {
+		return err
+	}
+
+	err = c.discoverContainers()
+	if err != nil {
+		return err
+	}
+
+	c.connectivityManager.Resync()
+
+	return nil
+}
+
+// reportLiveness sets the liveness status and node restart count annotations on the launcher pod
+// so the controller can reflect them in the topology status.
+func (c *clabernetes) reportLiveness(tracker *livenessTracker) {
+	patch, err := json.Marshal(
+		map[string]any{
+			"metadata": map[string]any{
+				"annotations": map[string]string{
+					clabernetesconstants.AnnotationNodeLiveness: string(tracker.status),
+					clabernetesconstants.AnnotationNodeRestarts: strconv.Itoa(tracker.restarts),
+				},
+			},
+		},
+	)
+	if err != nil {
+		c.logger.Warnf("failed marshaling liveness patch, err: %s", err)
+
+		return
+	}
+
+	_, err = c.kubeClient.CoreV1().
+		Pods(os.Getenv(clabernetesconstants.PodNamespaceEnv)).
+		Patch(
+			c.ctx,
+			os.Getenv(clabernetesconstants.PodNameEnv),
+			apimachinerytypes.MergePatchType,
+			patch,
+			metav1.PatchOptions{},
+		)
+	if err != nil {
+		c.logger.Warnf("failed reporting node liveness on launcher pod, err: %s", err)
+	}
+}
@@ -0,0 +1,141 @@
+package launcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+)
+
+const (
+	metricsNamespace      = "clabernetes_launcher"
+	metricsTimeout        = 5 * time.Second
+	metricsProbeTCP       = "tcp"
+	metricsProbeSSH       = "ssh"
+	metricsProbeHTTP      = "http"
+	metricsProbeGNMI      = "gnmi"
+	metricsProbeNETCONF   = "netconf"
+	metricsProbeExec      = "exec"
+	metricsRoute          = "/metrics"
+	metricsShutdownPeriod = 5 * time.Second
+)
+
+// launcherMetrics holds the (optional) launcher prometheus metrics -- the probe status gauge is
+// always populated by the probe loop, but the metrics are only actually served if enabled.
+type launcherMetrics struct {
+	registry     *prometheus.Registry
+	probeStatus  *prometheus.GaugeVec
+	restartsDesc *prometheus.Desc
+	c            *clabernetes
+}
+
+func newLauncherMetrics(c *clabernetes) *launcherMetrics {
+	m := &launcherMetrics{
+		registry: prometheus.NewRegistry(),
+		probeStatus: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace:   metricsNamespace,
+				Name:        "probe_status",
+				Help:        "Status of launcher status probes, 1 for passing, 0 for failing.",
+				ConstLabels: prometheus.Labels{"node": c.nodeName},
+			},
+			[]string{"probe"},
+		),
+		restartsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "container_restarts"),
+			"Number of times a container in the launcher has been restarted.",
+			[]string{"container_id"},
+			prometheus.Labels{"node": c.nodeName},
+		),
+		c: c,
+	}
+
+	m.registry.MustRegister(m.probeStatus, m)
+
+	return m
+}
+
+func (m *launcherMetrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.restartsDesc
+}
+
+func (m *launcherMetrics) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(m.c.ctx, metricsTimeout)
+	defer cancel()
+
+	for _, containerID := range m.c.loadContainerIDs() {
+		restartCount, err := getContainerRestartCount(ctx, containerID)
+		if err != nil {
+			m.c.logger.Debugf(
+				"failed getting restart count for container id %q, err: %s",
+				containerID,
+				err,
+			)
+
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			m.restartsDesc,
+			prometheus.CounterValue,
+			float64(restartCount),
+			containerID,
+		)
+	}
+}
+
+func (m *launcherMetrics) setProbeStatus(probe string, ok bool) {
+	var value float64
+	if ok {
+		value = 1
+	}
+
+	m.probeStatus.WithLabelValues(probe).Set(value)
+}
+
+func (c *clabernetes) serveMetrics() {
+	if !strings.EqualFold(
+		clabernetesutil.GetEnvStrOrDefault(clabernetesconstants.LauncherMetricsEnv, ""),
+		clabernetesconstants.True,
+	) {
+		c.logger.Debug("launcher metrics not enabled, skipping...")
+
+		return
+	}
+
+	c.logger.Infof("serving launcher metrics on port %d", clabernetesconstants.MetricsPort)
+
+	mux := http.NewServeMux()
+
+	mux.Handle(
+		metricsRoute,
+		promhttp.HandlerFor(c.metrics.registry, promhttp.HandlerOpts{}),
+	)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", clabernetesconstants.MetricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: metricsTimeout,
+	}
+
+	go func() {
+		<-c.ctx.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), metricsShutdownPeriod)
+		defer cancel()
+
+		_ = server.Shutdown(ctx)
+	}()
+
+	err := server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		c.logger.Warnf("launcher metrics server failed, err: %s", err)
+	}
+}
@@ -0,0 +1,93 @@
+package launcher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+)
+
+const (
+	// podmanSocket is the socket containerlab's podman runtime connects to.
+	podmanSocket            = "unix:///run/podman/podman.sock"
+	podmanRegistriesDir     = "/etc/containers/registries.conf.d"
+	podmanRegistriesConfig  = podmanRegistriesDir + "/99-clabernetes.conf"
+	maxPodmanLaunchAttempts = 10
+)
+
+func handlePodmanInsecureRegistries() error {
+	insecureRegistries := os.Getenv(clabernetesconstants.LauncherInsecureRegistries)
+
+	if insecureRegistries == "" {
+		return nil
+	}
+
+	var rendered strings.Builder
+
+	for _, registry := range strings.Split(insecureRegistries, ",") {
+		_, _ = fmt.Fprintf(&rendered, "[[registry]]\nlocation = %q\ninsecure = true\n\n", registry)
+	}
+
+	err := os.MkdirAll(
+		podmanRegistriesDir,
+		clabernetesconstants.PermissionsEveryoneReadWriteOwnerExecute,
+	)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(
+		podmanRegistriesConfig,
+		[]byte(rendered.String()),
+		clabernetesconstants.PermissionsEveryoneReadWriteOwnerExecute,
+	)
+}
+
+// startPodman starts the podman api service (containerlab's podman runtime talks to podman via
+// its api socket) and waits for it to be responsive -- unlike docker there is no daemon to run.
+func startPodman(ctx context.Context, logger io.Writer) error {
+	serviceCmd := exec.CommandContext(
+		ctx,
+		"podman",
+		"system",
+		"service",
+		"--time=0",
+		podmanSocket,
+	)
+
+	serviceCmd.Stdout = logger
+	serviceCmd.Stderr = logger
+
+	err := serviceCmd.Start()
+	if err != nil {
+		return err
+	}
+
+	var attempts int
+
+	for {
+		infoCmd := exec.CommandContext(ctx, "podman", "--url", podmanSocket, "info")
+
+		infoCmd.Stdout = io.Discard
+		infoCmd.Stderr = logger
+
+		err = infoCmd.Run()
+		if err == nil {
+			return nil
+		}
+
+		if attempts > maxPodmanLaunchAttempts {
+			return fmt.Errorf("%w: failed starting podman service", claberneteserrors.ErrLaunch)
+		}
+
+		time.Sleep(time.Second)
+
+		attempts++
+	}
+}
@@ -0,0 +1,629 @@
+package launcher
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	defaultSSHPort        = 22
+	defaultNETCONFPort    = 830
+	httpSchemeHTTPS       = "HTTPS"
+	netconfSubsystem      = "netconf"
+	netconfDelimiter      = "]]>]]>"
+	netconfHello          = "hello"
+	netconfReadBufferSize = 4096
+)
+
+// statusProbe is a single configured status probe -- name is the probe "kind" (and the value of
+// the "probe" label of the probe status metric), run executes the probe against the node.
+type statusProbe struct {
+	name string
+	run  func(ctx context.Context, nodeAddr string, timeout time.Duration) bool
+}
+
+func (c *clabernetes) runProbes() {
+	c.logger.Debug("starting status probe(s) if configured...")
+
+	probes := c.configuredStatusProbes()
+
+	if len(probes) == 0 {
+		c.logger.Debug("no probes configured, skipping status probes...")
+
+		return
+	}
+
+	probeInterval := time.Duration(
+		clabernetesutil.GetEnvIntOrDefault(
+			clabernetesconstants.LauncherProbeInterval,
+			statusProbeCheckIntervalSeconds,
+		),
+	) * time.Second
+
+	probeTimeout := time.Duration(
+		clabernetesutil.GetEnvIntOrDefault(
+			clabernetesconstants.LauncherProbeTimeout,
+			statusProbeCheckTimeoutSeconds,
+		),
+	) * time.Second
+
+	c.logger.Infof(
+		"starting status probes with interval %s and timeout %s...",
+		probeInterval,
+		probeTimeout,
+	)
+
+	liveness := c.newLivenessTracker()
+
+	ticker := time.NewTicker(probeInterval)
+
+	var nodeAddr string
+
+	// probe right away rather than only after the first interval, then on every tick, so the
+	// node status does not lag (any more than necessary) behind the actual node state
+	for ; true; <-ticker.C {
+		if nodeAddr == "" {
+			var err error
+
+			nodeAddr, err = getContainerAddr(c.ctx, c.nodeContainerID)
+			if err != nil {
+				c.logger.Warnf(
+					"failed determining node %q address, error: %s",
+					c.nodeName,
+					err,
+				)
+
+				continue
+			}
+		}
+
+		allProbesOk := true
+
+		for _, probe := range probes {
+			probeOk := probe.run(c.ctx, nodeAddr, probeTimeout)
+			if !probeOk {
+				c.logger.Debugf("%s status probe failed", probe.name)
+
+				allProbesOk = false
+			}
+
+			c.metrics.setProbeStatus(probe.name, probeOk)
+		}
+
+		var writeErr error
+
+		if allProbesOk {
+			writeErr = os.WriteFile(
+				clabernetesconstants.NodeStatusFile,
+				[]byte(clabernetesconstants.NodeStatusHealthy),
+				clabernetesconstants.PermissionsEveryoneAllPermissions,
+			)
+		} else {
+			writeErr = os.WriteFile(
+				clabernetesconstants.NodeStatusFile,
+				nil,
+				clabernetesconstants.PermissionsEveryoneAllPermissions,
+			)
+		}
+
+		if writeErr != nil {
+			c.logger.Criticalf(
+				"failed writing node status file, this probably should not happen, error: %s",
+				writeErr,
+			)
+
+			c.cancel()
+
+			return
+		}
+
+		if liveness != nil && c.handleLiveness(liveness, allProbesOk) {
+			// the node was redeployed, its address may have changed
+			nodeAddr = ""
+		}
+	}
+}
+
+// configuredStatusProbes returns the status probes configured (via env vars set by the controller)
+// for this launcher.
+func (c *clabernetes) configuredStatusProbes() []statusProbe {
+	probes := make([]statusProbe, 0)
+
+	for _, probe := range []*statusProbe{
+		c.tcpStatusProbe(),
+		c.sshStatusProbe(),
+		c.httpStatusProbe(),
+		c.gnmiStatusProbe(),
+		c.netconfStatusProbe(),
+		c.execStatusProbe(),
+	} {
+		if probe != nil {
+			probes = append(probes, *probe)
+		}
+	}
+
+	return probes
+}
+
+func (c *clabernetes) tcpStatusProbe() *statusProbe {
+	port := clabernetesutil.GetEnvIntOrDefault(clabernetesconstants.LauncherTCPProbePort, 0)
+	if port == 0 {
+		return nil
+	}
+
+	c.logger.Debugf("will run tcp status probe to port %d", port)
+
+	return &statusProbe{
+		name: metricsProbeTCP,
+		run: func(ctx context.Context, nodeAddr string, timeout time.Duration) bool {
+			dialer := net.Dialer{
+				Timeout: timeout,
+			}
+
+			tcpConn, err := dialer.DialContext(
+				ctx,
+				"tcp",
+				net.JoinHostPort(nodeAddr, strconv.Itoa(port)),
+			)
+			if err != nil {
+				return false
+			}
+
+			_ = tcpConn.Close()
+
+			return true
+		},
+	}
+}
+
+func (c *clabernetes) sshStatusProbe() *statusProbe {
+	port := clabernetesutil.GetEnvIntOrDefault(
+		clabernetesconstants.LauncherSSHProbePort,
+		defaultSSHPort,
+	)
+
+	username := os.Getenv(clabernetesconstants.LauncherSSHProbeUsername)
+
+	password := os.Getenv(clabernetesconstants.LauncherSSHProbePassword)
+
+	privateKey := os.Getenv(clabernetesconstants.LauncherSSHProbePrivateKey)
+
+	if username == "" || (password == "" && privateKey == "") {
+		return nil
+	}
+
+	authMethods := make([]ssh.AuthMethod, 0)
+
+	if privateKey != "" {
+		signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+		if err != nil {
+			c.logger.Warnf(
+				"failed parsing ssh status probe private key, ignoring it, error: %s",
+				err,
+			)
+		} else {
+			authMethods = append(authMethods, ssh.PublicKeys(signer))
+		}
+	}
+
+	if password != "" {
+		authMethods = append(authMethods, passwordAuthMethods(password)...)
+	}
+
+	if len(authMethods) == 0 {
+		c.logger.Warn("no usable ssh status probe credentials, skipping ssh probe")
+
+		return nil
+	}
+
+	c.logger.Debugf("will run ssh status probe using username %s to port %d", username, port)
+
+	return &statusProbe{
+		name: metricsProbeSSH,
+		run: func(_ context.Context, nodeAddr string, timeout time.Duration) bool {
+			return probeSSH(port, nodeAddr, username, authMethods, timeout)
+		},
+	}
+}
+
+func (c *clabernetes) httpStatusProbe() *statusProbe {
+	port := clabernetesutil.GetEnvIntOrDefault(clabernetesconstants.LauncherHTTPProbePort, 0)
+	if port == 0 {
+		return nil
+	}
+
+	path := clabernetesutil.GetEnvStrOrDefault(clabernetesconstants.LauncherHTTPProbePath, "/")
+
+	scheme := "http"
+	if os.Getenv(clabernetesconstants.LauncherHTTPProbeScheme) == httpSchemeHTTPS {
+		scheme = "https"
+	}
+
+	expectedStatusCode := clabernetesutil.GetEnvIntOrDefault(
+		clabernetesconstants.LauncherHTTPProbeExpectedStatusCode,
+		0,
+	)
+
+	c.logger.Debugf("will run http status probe to %s port %d path %q", scheme, port, path)
+
+	return &statusProbe{
+		name: metricsProbeHTTP,
+		run: func(ctx context.Context, nodeAddr string, timeout time.Duration) bool {
+			return probeHTTP(
+				ctx,
+				fmt.Sprintf(
+					"%s://%s%s",
+					scheme,
+					net.JoinHostPort(nodeAddr, strconv.Itoa(port)),
+					path,
+				),
+				expectedStatusCode,
+				timeout,
+			)
+		},
+	}
+}
+
+func (c *clabernetes) gnmiStatusProbe() *statusProbe {
+	port := clabernetesutil.GetEnvIntOrDefault(clabernetesconstants.LauncherGNMIProbePort, 0)
+	if port == 0 {
+		return nil
+	}
+
+	username := os.Getenv(clabernetesconstants.LauncherGNMIProbeUsername)
+
+	password := os.Getenv(clabernetesconstants.LauncherGNMIProbePassword)
+
+	plaintext := clabernetesutil.GetEnvBoolOrDefault(
+		clabernetesconstants.LauncherGNMIProbeInsecure,
+		false,
+	)
+
+	c.logger.Debugf("will run gnmi status probe to port %d", port)
+
+	return &statusProbe{
+		name: metricsProbeGNMI,
+		run: func(ctx context.Context, nodeAddr string, timeout time.Duration) bool {
+			return probeGNMI(
+				ctx,
+				net.JoinHostPort(nodeAddr, strconv.Itoa(port)),
+				username,
+				password,
+				plaintext,
+				timeout,
+			)
+		},
+	}
+}
+
+func (c *clabernetes) netconfStatusProbe() *statusProbe {
+	port := clabernetesutil.GetEnvIntOrDefault(
+		clabernetesconstants.LauncherNETCONFProbePort,
+		defaultNETCONFPort,
+	)
+
+	username := os.Getenv(clabernetesconstants.LauncherNETCONFProbeUsername)
+
+	password := os.Getenv(clabernetesconstants.LauncherNETCONFProbePassword)
+
+	if username == "" || password == "" {
+		return nil
+	}
+
+	c.logger.Debugf(
+		"will run netconf status probe using username %s to port %d",
+		username,
+		port,
+	)
+
+	return &statusProbe{
+		name: metricsProbeNETCONF,
+		run: func(_ context.Context, nodeAddr string, timeout time.Duration) bool {
+			return probeNETCONF(
+				net.JoinHostPort(nodeAddr, strconv.Itoa(port)),
+				username,
+				password,
+				timeout,
+			)
+		},
+	}
+}
+
+func (c *clabernetes) execStatusProbe() *statusProbe {
+	rawCommand := os.Getenv(clabernetesconstants.LauncherExecProbeCommand)
+	if rawCommand == "" {
+		return nil
+	}
+
+	var command []string
+
+	err := json.Unmarshal([]byte(rawCommand), &command)
+	if err != nil || len(command) == 0 {
+		c.logger.Warnf(
+			"failed parsing exec status probe command %q, skipping exec probe, error: %s",
+			rawCommand,
+			err,
+		)
+
+		return nil
+	}
+
+	var expectedOutput *regexp.Regexp
+
+	rawExpectedOutput := os.Getenv(clabernetesconstants.LauncherExecProbeExpectedOutput)
+	if rawExpectedOutput != "" {
+		expectedOutput, err = regexp.Compile(rawExpectedOutput)
+		if err != nil {
+			c.logger.Warnf(
+				"failed compiling exec status probe expected output %q, skipping exec probe,"+
+					" error: %s",
+				rawExpectedOutput,
+				err,
+			)
+
+			return nil
+		}
+	}
+
+	c.logger.Debugf("will run exec status probe with command %q", command)
+
+	return &statusProbe{
+		name: metricsProbeExec,
+		run: func(ctx context.Context, _ string, timeout time.Duration) bool {
+			return probeExec(ctx, c.nodeContainerID, command, expectedOutput, timeout)
+		},
+	}
+}
+
+// passwordAuthMethods returns the auth methods for password based auth -- plain password and, as
+// some nodes only offer that, keyboard interactive answering all questions with the password.
+func passwordAuthMethods(password string) []ssh.AuthMethod {
+	return []ssh.AuthMethod{
+		ssh.Password(password),
+		ssh.KeyboardInteractive(
+			func(_, _ string, questions []string, _ []bool) ([]string, error) {
+				answers := make([]string, len(questions))
+				for i := range answers {
+					answers[i] = password
+				}
+
+				return answers, nil
+			},
+		),
+	}
+}
+
+func probeSSH(
+	port int,
+	nodeAddr, username string,
+	authMethods []ssh.AuthMethod,
+	timeout time.Duration,
+) bool {
+	sshConfig := &ssh.ClientConfig{
+		User:            username,
+		Auth:            authMethods,
+		Timeout:         timeout,
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(), //nolint:gosec
+	}
+
+	conn, err := ssh.Dial(
+		"tcp",
+		fmt.Sprintf("%s:%d", nodeAddr, port),
+		sshConfig,
+	)
+	if err != nil {
+		return false
+	}
+
+	_ = conn.Close()
+
+	return true
+}
+
+func probeHTTP(
+	ctx context.Context,
+	url string,
+	expectedStatusCode int,
+	timeout time.Duration,
+) bool {
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true, //nolint:gosec
+			},
+		},
+		// a redirect is an answer from the node, so we check the redirect status code rather than
+		// wherever the redirect points to
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	defer client.CloseIdleConnections()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return false
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return false
+	}
+
+	_ = response.Body.Close()
+
+	if expectedStatusCode != 0 {
+		return response.StatusCode == expectedStatusCode
+	}
+
+	return response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusBadRequest
+}
+
+func probeGNMI(
+	ctx context.Context,
+	target, username, password string,
+	plaintext bool,
+	timeout time.Duration,
+) bool {
+	transportCredentials := insecure.NewCredentials()
+	if !plaintext {
+		transportCredentials = credentials.NewTLS(
+			&tls.Config{
+				InsecureSkipVerify: true, //nolint:gosec
+			},
+		)
+	}
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return false
+	}
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	probeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if username != "" {
+		probeCtx = metadata.AppendToOutgoingContext(
+			probeCtx,
+			"username",
+			username,
+			"password",
+			password,
+		)
+	}
+
+	_, err = gnmi.NewGNMIClient(conn).Capabilities(probeCtx, &gnmi.CapabilityRequest{})
+
+	return err == nil
+}
+
+func probeNETCONF(target, username, password string, timeout time.Duration) bool {
+	conn, err := net.DialTimeout("tcp", target, timeout)
+	if err != nil {
+		return false
+	}
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// the deadline covers the whole exchange -- ssh handshake, subsystem request and reading the
+	// hello message
+	err = conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return false
+	}
+
+	sshConn, channels, requests, err := ssh.NewClientConn(
+		conn,
+		target,
+		&ssh.ClientConfig{
+			User: username,
+			Auth: []ssh.AuthMethod{
+				ssh.Password(password),
+			},
+			Timeout:         timeout,
+			HostKeyCallback: ssh.InsecureIgnoreHostKey(), //nolint:gosec
+		},
+	)
+	if err != nil {
+		return false
+	}
+
+	client := ssh.NewClient(sshConn, channels, requests)
+
+	defer func() {
+		_ = client.Close()
+	}()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return false
+	}
+
+	defer func() {
+		_ = session.Close()
+	}()
+
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return false
+	}
+
+	err = session.RequestSubsystem(netconfSubsystem)
+	if err != nil {
+		return false
+	}
+
+	return readNETCONFHello(stdout)
+}
+
+// readNETCONFHello reads from the given reader until the (netconf 1.0) end of message delimiter and
+// reports whether what was read is a hello message. The hello message is not necessarily newline
+// terminated, so this just reads whatever is there rather than reading lines.
+func readNETCONFHello(r io.Reader) bool {
+	var hello bytes.Buffer
+
+	buf := make([]byte, netconfReadBufferSize)
+
+	for {
+		n, readErr := r.Read(buf)
+
+		hello.Write(buf[:n])
+
+		if bytes.Contains(hello.Bytes(), []byte(netconfDelimiter)) {
+			return bytes.Contains(hello.Bytes(), []byte(netconfHello))
+		}
+
+		if readErr != nil {
+			return false
+		}
+	}
+}
+
+func probeExec(
+	ctx context.Context,
+	containerID string,
+	command []string,
+	expectedOutput *regexp.Regexp,
+	timeout time.Duration,
+) bool {
+	probeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	output, err := execInContainer(probeCtx, containerID, command)
+	if err != nil {
+		return false
+	}
+
+	if expectedOutput == nil {
+		return true
+	}
+
+	return expectedOutput.Match(output)
+}
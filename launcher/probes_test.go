@@ -0,0 +1,227 @@
+package launcher //nolint:testpackage // tests cover unexported probe helpers
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testProbeTimeout = 5 * time.Second
+
+func TestProbeHTTP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		tls                bool
+		path               string
+		expectedStatusCode int
+		expected           bool
+	}{
+		{
+			name:     "ok",
+			path:     "/ok",
+			expected: true,
+		},
+		{
+			name:     "server-error",
+			path:     "/error",
+			expected: false,
+		},
+		{
+			name:     "not-found",
+			path:     "/not-found",
+			expected: false,
+		},
+		{
+			name:               "expected-status-code",
+			path:               "/not-found",
+			expectedStatusCode: http.StatusNotFound,
+			expected:           true,
+		},
+		{
+			name:               "unexpected-status-code",
+			path:               "/ok",
+			expectedStatusCode: http.StatusNoContent,
+			expected:           false,
+		},
+		{
+			// the redirect target errors, so this only passes if the redirect is not followed
+			name:     "redirect-not-followed",
+			path:     "/redirect",
+			expected: true,
+		},
+		{
+			name:               "redirect-expected-status-code",
+			path:               "/redirect",
+			expectedStatusCode: http.StatusFound,
+			expected:           true,
+		},
+		{
+			name:     "tls-self-signed",
+			tls:      true,
+			path:     "/ok",
+			expected: true,
+		},
+		{
+			name:     "tls-server-error",
+			tls:      true,
+			path:     "/error",
+			expected: false,
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/error", http.StatusFound)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	tlsServer := httptest.NewTLSServer(mux)
+	t.Cleanup(tlsServer.Close)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Logf("%s: starting", tt.name)
+
+			url := server.URL + tt.path
+			if tt.tls {
+				url = tlsServer.URL + tt.path
+			}
+
+			got := probeHTTP(context.Background(), url, tt.expectedStatusCode, testProbeTimeout)
+			if got != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestProbeHTTPPlaintextToTLS(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+	t.Cleanup(server.Close)
+
+	// speaking plain http to a tls listener gets a 400 from the go tls server, so the probe fails
+	url := "http://" + server.Listener.Addr().String() + "/"
+
+	if probeHTTP(context.Background(), url, 0, testProbeTimeout) {
+		t.Fatal("expected plaintext probe against tls server to fail")
+	}
+}
+
+func TestProbeHTTPUnreachable(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL + "/"
+
+	server.Close()
+
+	if probeHTTP(context.Background(), url, 0, testProbeTimeout) {
+		t.Fatal("expected probe against closed server to fail")
+	}
+}
+
+func TestReadNETCONFHello(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		chunks   []string
+		expected bool
+	}{
+		{
+			name: "hello",
+			chunks: []string{
+				`<?xml version="1.0" encoding="UTF-8"?><hello xmlns="urn:ietf:params:xml:ns:` +
+					`netconf:base:1.0"><capabilities><capability>urn:ietf:params:netconf:base:` +
+					`1.0</capability></capabilities><session-id>1</session-id></hello>]]>]]>`,
+			},
+			expected: true,
+		},
+		{
+			name: "hello-split-across-reads",
+			chunks: []string{
+				`<?xml version="1.0" encoding="UTF-8"?><hel`,
+				`lo xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"></hello>]]>`,
+				`]]>`,
+			},
+			expected: true,
+		},
+		{
+			name: "not-hello",
+			chunks: []string{
+				`<rpc-error><error-message>nope</error-message></rpc-error>]]>]]>`,
+			},
+			expected: false,
+		},
+		{
+			name: "closed-before-delimiter",
+			chunks: []string{
+				`<?xml version="1.0" encoding="UTF-8"?><hello>`,
+			},
+			expected: false,
+		},
+		{
+			name:     "closed-without-data",
+			chunks:   nil,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Logf("%s: starting", tt.name)
+
+			server, client := net.Pipe()
+
+			t.Cleanup(func() {
+				_ = client.Close()
+			})
+
+			go func() {
+				defer func() {
+					_ = server.Close()
+				}()
+
+				for _, chunk := range tt.chunks {
+					_, err := server.Write([]byte(chunk))
+					if err != nil {
+						return
+					}
+				}
+			}()
+
+			err := client.SetDeadline(time.Now().Add(testProbeTimeout))
+			if err != nil {
+				t.Fatalf("failed setting deadline, error: %s", err)
+			}
+
+			got := readNETCONFHello(client)
+			if got != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package launcher
+
+import (
+	"os/exec"
+)
+
+// segment creates the linux bridge for a segment (bridge kind) node -- containerlab expects the
+// bridge of "bridge" kind nodes to already exist, it only attaches the node links to it. The
+// bridge is named after the node and (unsurprisingly) is what makes the segment a multi-access
+// (lan) segment: every link to the segment node is a port on this bridge, whether the other end
+// of the link is local or a tunnel to another launcher.
+func (c *clabernetes) segment() {
+	c.logger.Infof("node %q is a segment, ensuring bridge exists...", c.nodeName)
+
+	checkCmd := exec.CommandContext(c.ctx, "ip", "link", "show", c.nodeName) //nolint:gosec
+
+	if checkCmd.Run() != nil {
+		c.logger.Debugf("creating bridge %q", c.nodeName)
+
+		err := c.runIPCommand("link", "add", "name", c.nodeName, "type", "bridge")
+		if err != nil {
+			c.logger.Fatalf("failed creating segment bridge %q, err: %s", c.nodeName, err)
+		}
+	}
+
+	err := c.runIPCommand("link", "set", c.nodeName, "up")
+	if err != nil {
+		c.logger.Fatalf("failed setting segment bridge %q up, err: %s", c.nodeName, err)
+	}
+
+	c.logger.Debug("segment bridge ready")
+}
+
+func (c *clabernetes) runIPCommand(args ...string) error {
+	cmd := exec.CommandContext(c.ctx, "ip", args...)
+
+	cmd.Stdout = c.logger
+	cmd.Stderr = c.logger
+
+	return cmd.Run()
+}
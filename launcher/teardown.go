@@ -0,0 +1,77 @@
+package launcher
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+)
+
+// teardown gracefully tears down the containerlab topology -- it is called once the launcher
+// context is done (usually because the pod is being terminated, for example when the owning
+// Topology is deleted). When requested the node configuration is saved (into the lab directory
+// which may be persisted in a pvc) before the lab is destroyed.
+func (c *clabernetes) teardown() {
+	c.logger.Info("tearing down containerlab topology...")
+
+	// the launcher context is already canceled at this point, so we need a fresh one
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		clabernetesconstants.LauncherTeardownTimeout,
+	)
+	defer cancel()
+
+	if os.Getenv(clabernetesconstants.LauncherContainerlabSaveOnTeardown) ==
+		clabernetesconstants.True {
+		c.logger.Debug("saving node configuration before destroying topology...")
+
+		err := c.runContainerlabTeardownCommand(ctx, "save")
+		if err != nil {
+			c.logger.Warnf(
+				"failed saving node configuration, will continue destroying topology, err: %s",
+				err,
+			)
+		}
+	}
+
+	err := c.runContainerlabTeardownCommand(ctx, "destroy")
+	if err != nil {
+		c.logger.Criticalf("failed destroying containerlab topology, err: %s", err)
+
+		return
+	}
+
+	c.logger.Info("containerlab topology destroyed")
+}
+
+func (c *clabernetes) runContainerlabTeardownCommand(ctx context.Context, command string) error {
+	containerlabLogFile, err := os.OpenFile(
+		"containerlab.log",
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		clabernetesconstants.PermissionsEveryoneReadWrite,
+	)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = containerlabLogFile.Close()
+	}()
+
+	containerlabOutWriter := io.MultiWriter(c.containerlabLogger, containerlabLogFile)
+
+	args := append(
+		[]string{command, "-t", "topo.clab.yaml"},
+		clabernetesutilcontainerlab.RuntimeArgs()...,
+	)
+
+	cmd := exec.CommandContext(ctx, "containerlab", args...) //nolint:gosec
+
+	cmd.Stdout = containerlabOutWriter
+	cmd.Stderr = containerlabOutWriter
+
+	return cmd.Run()
+}
@@ -0,0 +1,90 @@
+package launcher
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	waitForPollInterval = 5 * time.Second
+	waitForLogCounter   = 6
+)
+
+// waitForNodes blocks until the nodes of other launchers that our sub-topology depends on (via
+// containerlab wait-for/stages) are reported ready in the topology status -- containerlab can only
+// order nodes within a single topology, so we handle the dependencies across launchers here.
+func (c *clabernetes) waitForNodes() {
+	rawWaitForNodes := os.Getenv(clabernetesconstants.LauncherWaitForNodesEnv)
+	if rawWaitForNodes == "" {
+		c.logger.Debug("no nodes to wait for, continuing...")
+
+		return
+	}
+
+	waitForNodes := strings.Split(rawWaitForNodes, ",")
+
+	c.logger.Infof("waiting for node(s) %q to be ready before launching...", waitForNodes)
+
+	ticker := time.NewTicker(waitForPollInterval)
+	defer ticker.Stop()
+
+	var checkCounter int
+
+	for {
+		notReadyNodes, err := c.notReadyNodes(waitForNodes)
+		if err != nil {
+			c.logger.Warnf("failed fetching topology status, will retry, err: %s", err)
+		} else if len(notReadyNodes) == 0 {
+			c.logger.Infof("node(s) %q are ready, continuing...", waitForNodes)
+
+			return
+		}
+
+		checkCounter++
+
+		if checkCounter == waitForLogCounter {
+			checkCounter = 0
+
+			c.logger.Infof("still waiting for node(s) %q to be ready...", notReadyNodes)
+		}
+
+		select {
+		case <-c.ctx.Done():
+			c.logger.Fatal("context cancelled while waiting for nodes to be ready")
+		case <-ticker.C:
+		}
+	}
+}
+
+// notReadyNodes returns the nodes from the given slice that are not reported ready in the
+// topology status.
+func (c *clabernetes) notReadyNodes(nodeNames []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(c.ctx, clientDefaultTimeout)
+	defer cancel()
+
+	topology, err := c.kubeClabernetesClient.ClabernetesV1alpha1().
+		Topologies(os.Getenv(clabernetesconstants.PodNamespaceEnv)).
+		Get(
+			ctx,
+			os.Getenv(clabernetesconstants.LauncherTopologyNameEnv),
+			metav1.GetOptions{},
+		)
+	if err != nil {
+		return nil, err
+	}
+
+	notReadyNodes := make([]string, 0)
+
+	for _, nodeName := range nodeNames {
+		if topology.Status.NodeReadiness[nodeName] != clabernetesconstants.NodeStatusReady {
+			notReadyNodes = append(notReadyNodes, nodeName)
+		}
+	}
+
+	return notReadyNodes, nil
+}
@@ -6,10 +6,13 @@ import (
 	"os"
 	"time"
 
+	clabernetesconfig "github.com/srl-labs/clabernetes/config"
 	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
 	clabernetesgeneratedclientset "github.com/srl-labs/clabernetes/generated/clientset"
 	claberneteshttp "github.com/srl-labs/clabernetes/http"
 	claberneteslogging "github.com/srl-labs/clabernetes/logging"
+	clabernetesmanagertypes "github.com/srl-labs/clabernetes/manager/types"
+	clabernetesmetrics "github.com/srl-labs/clabernetes/metrics"
 	clabernetesutil "github.com/srl-labs/clabernetes/util"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
@@ -18,6 +21,7 @@ import (
 	ctrlruntime "sigs.k8s.io/controller-runtime"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntimelog "sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlruntimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 const (
@@ -220,8 +224,30 @@ func (c *clabernetes) start() {
 		c.Exit(clabernetesconstants.ExitCodeError)
 	}
 
+	err = clabernetesmetrics.Register(ctrlruntimemetrics.Registry, c.mgr.GetCache())
+	if err != nil {
+		c.logger.Criticalf("failed registering metrics collectors, err: %s", err)
+
+		c.Exit(clabernetesconstants.ExitCodeError)
+	}
+
 	c.logger.Debug("prepare complete...")
 
+	// the config manager is started prior to leader election (rather than in pre-start) as the
+	// admission webhooks are served by every manager replica and need the global config to be
+	// available to them
+	c.logger.Info("starting config manager...")
+
+	err = startConfig(c)
+	if err != nil {
+		// we *shouldn't* actually ever hit this as the config manager can start and *not* find a
+		// config that it manages just fine, but i guess its possible that something terrible
+		// could happen that would prevent us from continuing.
+		c.logger.Fatalf("failed starting config manager, err: %s", err)
+	}
+
+	c.logger.Debug("config manager started...")
+
 	c.logger.Info("starting http manager...")
 
 	claberneteshttp.InitManager(c)
@@ -231,3 +257,22 @@ func (c *clabernetes) start() {
 
 	c.startLeaderElection()
 }
+
+// startConfig initializes and starts the config manager singleton.
+func startConfig(c clabernetesmanagertypes.Clabernetes) error {
+	clabernetesconfig.InitManager(
+		c.GetContext(),
+		c.GetAppName(),
+		c.GetNamespace(),
+		c.GetKubeClabernetesClient(),
+	)
+
+	configManager := clabernetesconfig.GetManager()
+
+	err := configManager.Start()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -27,6 +27,15 @@ func (c *clabernetes) init(ctx context.Context) {
 
 	c.logger.Debug("initializing crds complete...")
 
+	c.logger.Info("initializing webhooks...")
+
+	err = initializeWebhooks(c)
+	if err != nil {
+		c.logger.Fatalf("failed initializing webhooks, err: %s", err)
+	}
+
+	c.logger.Debug("initializing webhooks complete...")
+
 	c.logger.Info("initializing global config...")
 
 	initializeConfig(c)
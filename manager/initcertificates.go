@@ -3,7 +3,9 @@ package manager
 import (
 	cryptorand "crypto/rand"
 	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"slices"
 
 	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
 	clabernetesmanagertypes "github.com/srl-labs/clabernetes/manager/types"
@@ -43,6 +45,16 @@ func initializeCertificates(c clabernetesmanagertypes.Clabernetes) error { //nol
 		certsExist = false
 	}
 
+	webhookDNSNames := getWebhookCertificateDNSNames(c)
+
+	if certsExist && !certificateHasDNSNames(secret.Data["webhook-tls.crt"], webhookDNSNames) {
+		logger.Info(
+			"webhook certificate does not cover all required dns names, regenerating certificates",
+		)
+
+		certsExist = false
+	}
+
 	if certsExist {
 		logger.Info("all certificates secret data present, nothing to do")
 
@@ -103,10 +115,7 @@ func initializeCertificates(c clabernetesmanagertypes.Clabernetes) error { //nol
 
 	// webhook
 	webhookCert := clabernetesutil.CreateClientCertificate("webhook")
-	webhookCert.DNSNames = []string{
-		"localhost",
-		fmt.Sprintf("%s-webhook.%s.svc", c.GetAppName(), c.GetNamespace()),
-	}
+	webhookCert.DNSNames = webhookDNSNames
 
 	webhookCertKey := clabernetesutil.MustGeneratePrivateKey(clabernetesconstants.KeySize)
 
@@ -142,6 +151,36 @@ func initializeCertificates(c clabernetesmanagertypes.Clabernetes) error { //nol
 	return nil
 }
 
+func getWebhookCertificateDNSNames(c clabernetesmanagertypes.Clabernetes) []string {
+	return []string{
+		"localhost",
+		fmt.Sprintf("%s-webhook.%s.svc", c.GetAppName(), c.GetNamespace()),
+		fmt.Sprintf("%s.%s.svc", getHTTPServiceName(c), c.GetNamespace()),
+	}
+}
+
+// certificateHasDNSNames returns true if the given pem encoded certificate includes all the given
+// dns names in its subject alternative names.
+func certificateHasDNSNames(certPEM []byte, dnsNames []string) bool {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return false
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+
+	for _, dnsName := range dnsNames {
+		if !slices.Contains(cert.DNSNames, dnsName) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func updateCertificateSecret(
 	c clabernetesmanagertypes.Clabernetes,
 	secret *k8scorev1.Secret,
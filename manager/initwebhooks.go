@@ -0,0 +1,218 @@
+package manager
+
+import (
+	"fmt"
+
+	clabernetesapis "github.com/srl-labs/clabernetes/apis"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
+	clabernetesmanagertypes "github.com/srl-labs/clabernetes/manager/types"
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
+	k8sadmissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func initializeWebhooks(c clabernetesmanagertypes.Clabernetes) error {
+	secret, err := getCertificatesSecret(c)
+	if err != nil {
+		return fmt.Errorf("getting certificates secret: %w", err)
+	}
+
+	caBundle, ok := secret.Data["webhook-ca.crt"]
+	if !ok {
+		return fmt.Errorf(
+			"%w: certificates secret missing webhook ca data",
+			claberneteserrors.ErrPrepare,
+		)
+	}
+
+	err = applyValidatingWebhookConfiguration(c, renderValidatingWebhookConfiguration(c, caBundle))
+	if err != nil {
+		return fmt.Errorf("applying validating webhook configuration: %w", err)
+	}
+
+	err = applyMutatingWebhookConfiguration(c, renderMutatingWebhookConfiguration(c, caBundle))
+	if err != nil {
+		return fmt.Errorf("applying mutating webhook configuration: %w", err)
+	}
+
+	return nil
+}
+
+func renderWebhookClientConfig(
+	c clabernetesmanagertypes.Clabernetes,
+	caBundle []byte,
+	path string,
+) k8sadmissionregistrationv1.WebhookClientConfig {
+	return k8sadmissionregistrationv1.WebhookClientConfig{
+		Service: &k8sadmissionregistrationv1.ServiceReference{
+			Namespace: c.GetNamespace(),
+			Name:      getHTTPServiceName(c),
+			Path:      clabernetesutil.ToPointer(path),
+			Port:      clabernetesutil.ToPointer(int32(clabernetesconstants.PortHTTPS)),
+		},
+		CABundle: caBundle,
+	}
+}
+
+func renderTopologyWebhookRule(
+	operations ...k8sadmissionregistrationv1.OperationType,
+) []k8sadmissionregistrationv1.RuleWithOperations {
+	return []k8sadmissionregistrationv1.RuleWithOperations{
+		{
+			Operations: operations,
+			Rule: k8sadmissionregistrationv1.Rule{
+				APIGroups:   []string{clabernetesapis.Group},
+				APIVersions: []string{"v1alpha1"},
+				Resources:   []string{"topologies"},
+				Scope: clabernetesutil.ToPointer(
+					k8sadmissionregistrationv1.NamespacedScope,
+				),
+			},
+		},
+	}
+}
+
+func renderValidatingWebhookConfiguration(
+	c clabernetesmanagertypes.Clabernetes,
+	caBundle []byte,
+) *k8sadmissionregistrationv1.ValidatingWebhookConfiguration {
+	// ignore rather than fail -- the webhook configuration is not owned by the chart, so if the
+	// manager is gone (uninstalled or just down) we dont want to wedge topology create/updates
+	failurePolicy := k8sadmissionregistrationv1.Ignore
+	sideEffects := k8sadmissionregistrationv1.SideEffectClassNone
+	matchPolicy := k8sadmissionregistrationv1.Equivalent
+	timeoutSeconds := int32(clabernetesconstants.WebhookTimeoutSeconds)
+
+	return &k8sadmissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-validating-webhook", c.GetAppName()),
+			Labels: map[string]string{
+				clabernetesconstants.LabelApp: c.GetAppName(),
+			},
+		},
+		Webhooks: []k8sadmissionregistrationv1.ValidatingWebhook{
+			{
+				Name: fmt.Sprintf("topology.%s", clabernetesapis.Group),
+				ClientConfig: renderWebhookClientConfig(
+					c,
+					caBundle,
+					clabernetesconstants.ValidateTopologyWebhookPath,
+				),
+				Rules: renderTopologyWebhookRule(
+					k8sadmissionregistrationv1.Create,
+					k8sadmissionregistrationv1.Update,
+				),
+				FailurePolicy:           &failurePolicy,
+				MatchPolicy:             &matchPolicy,
+				SideEffects:             &sideEffects,
+				NamespaceSelector:       &metav1.LabelSelector{},
+				ObjectSelector:          &metav1.LabelSelector{},
+				TimeoutSeconds:          &timeoutSeconds,
+				AdmissionReviewVersions: []string{"v1"},
+			},
+		},
+	}
+}
+
+func applyValidatingWebhookConfiguration(
+	c clabernetesmanagertypes.Clabernetes,
+	webhookConfiguration *k8sadmissionregistrationv1.ValidatingWebhookConfiguration,
+) error {
+	ctx, ctxCancel := c.NewContextWithTimeout()
+	defer ctxCancel()
+
+	client := c.GetKubeClient().AdmissionregistrationV1().ValidatingWebhookConfigurations()
+
+	current, err := client.Get(ctx, webhookConfiguration.Name, metav1.GetOptions{})
+	if err != nil && apimachineryerrors.IsNotFound(err) {
+		_, err = client.Create(ctx, webhookConfiguration, metav1.CreateOptions{})
+
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(current.Webhooks, webhookConfiguration.Webhooks) {
+		return nil
+	}
+
+	current.Webhooks = webhookConfiguration.Webhooks
+
+	_, err = client.Update(ctx, current, metav1.UpdateOptions{})
+
+	return err
+}
+
+func renderMutatingWebhookConfiguration(
+	c clabernetesmanagertypes.Clabernetes,
+	caBundle []byte,
+) *k8sadmissionregistrationv1.MutatingWebhookConfiguration {
+	// see validating webhook for why we ignore failures
+	failurePolicy := k8sadmissionregistrationv1.Ignore
+	sideEffects := k8sadmissionregistrationv1.SideEffectClassNone
+	matchPolicy := k8sadmissionregistrationv1.Equivalent
+	reinvocationPolicy := k8sadmissionregistrationv1.NeverReinvocationPolicy
+	timeoutSeconds := int32(clabernetesconstants.WebhookTimeoutSeconds)
+
+	return &k8sadmissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-mutating-webhook", c.GetAppName()),
+			Labels: map[string]string{
+				clabernetesconstants.LabelApp: c.GetAppName(),
+			},
+		},
+		Webhooks: []k8sadmissionregistrationv1.MutatingWebhook{
+			{
+				Name: fmt.Sprintf("topology.%s", clabernetesapis.Group),
+				ClientConfig: renderWebhookClientConfig(
+					c,
+					caBundle,
+					clabernetesconstants.MutateTopologyWebhookPath,
+				),
+				Rules: renderTopologyWebhookRule(
+					k8sadmissionregistrationv1.Create,
+				),
+				FailurePolicy:           &failurePolicy,
+				MatchPolicy:             &matchPolicy,
+				SideEffects:             &sideEffects,
+				ReinvocationPolicy:      &reinvocationPolicy,
+				NamespaceSelector:       &metav1.LabelSelector{},
+				ObjectSelector:          &metav1.LabelSelector{},
+				TimeoutSeconds:          &timeoutSeconds,
+				AdmissionReviewVersions: []string{"v1"},
+			},
+		},
+	}
+}
+
+func applyMutatingWebhookConfiguration(
+	c clabernetesmanagertypes.Clabernetes,
+	webhookConfiguration *k8sadmissionregistrationv1.MutatingWebhookConfiguration,
+) error {
+	ctx, ctxCancel := c.NewContextWithTimeout()
+	defer ctxCancel()
+
+	client := c.GetKubeClient().AdmissionregistrationV1().MutatingWebhookConfigurations()
+
+	current, err := client.Get(ctx, webhookConfiguration.Name, metav1.GetOptions{})
+	if err != nil && apimachineryerrors.IsNotFound(err) {
+		_, err = client.Create(ctx, webhookConfiguration, metav1.CreateOptions{})
+
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(current.Webhooks, webhookConfiguration.Webhooks) {
+		return nil
+	}
+
+	current.Webhooks = webhookConfiguration.Webhooks
+
+	_, err = client.Update(ctx, current, metav1.UpdateOptions{})
+
+	return err
+}
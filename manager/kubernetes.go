@@ -1,7 +1,11 @@
 package manager
 
 import (
+	"fmt"
+
 	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	k8scorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -19,7 +23,7 @@ func newManager(scheme *apimachineryruntime.Scheme, appName string) (ctrlruntime
 			Logger: klog.NewKlogr(),
 			Scheme: scheme,
 			Metrics: ctrlruntimemetricsserver.Options{
-				BindAddress: "0",
+				BindAddress: fmt.Sprintf(":%d", clabernetesconstants.MetricsPort),
 			},
 			LeaderElection: false,
 			NewCache: func(
@@ -74,6 +78,19 @@ func newManager(scheme *apimachineryruntime.Scheme, appName string) (ctrlruntime
 							},
 						},
 					},
+					// kubernetes nodes are used to figure out where to prewarm topology
+					// images, they are obviously not ours so they wont have our label either
+					&k8scorev1.Node{}: {
+						Label: labels.Everything(),
+					},
+					// and topology snapshots, users create these so they wont have our label
+					&clabernetesapisv1alpha1.TopologySnapshot{}: {
+						Namespaces: map[string]ctrlruntimecache.Config{
+							ctrlruntimecache.AllNamespaces: {
+								LabelSelector: labels.Everything(),
+							},
+						},
+					},
 				}
 
 				return ctrlruntimecache.New(config, opts)
@@ -3,7 +3,6 @@ package manager
 import (
 	"strings"
 
-	clabernetesconfig "github.com/srl-labs/clabernetes/config"
 	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
 	clabernetesmanagertypes "github.com/srl-labs/clabernetes/manager/types"
 	clabernetesutil "github.com/srl-labs/clabernetes/util"
@@ -13,18 +12,6 @@ import (
 func (c *clabernetes) preStart() {
 	c.logger.Info("begin pre-start...")
 
-	c.logger.Info("starting config manager...")
-
-	err := preStartConfig(c)
-	if err != nil {
-		// we *shouldn't* actually ever hit this as the config manager can start and *not* find a
-		// config that it manages just fine, but i guess its possible that something terrible
-		// could happen that would prevent us from continuing.
-		c.logger.Fatalf("failed starting config manager, err: %s", err)
-	}
-
-	c.logger.Debug("config manager started...")
-
 	c.logger.Info("determining cri sameness (or not)...")
 
 	nodeCriKind, err := cri(c)
@@ -39,25 +26,6 @@ func (c *clabernetes) preStart() {
 	c.logger.Debug("pre-start complete...")
 }
 
-// config initializes the config manager singleton.
-func preStartConfig(c clabernetesmanagertypes.Clabernetes) error {
-	clabernetesconfig.InitManager(
-		c.GetContext(),
-		c.GetAppName(),
-		c.GetNamespace(),
-		c.GetKubeClabernetesClient(),
-	)
-
-	configManager := clabernetesconfig.GetManager()
-
-	err := configManager.Start()
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // cri fetches all the nodes in the cluster to determine if the cri(s) in use are the same across
 // all nodes. we do this to know if we can/should (if configured to do so) enable "cri pull through"
 // mode for images. in this mode launcher pods are configured to pull images directly via the
@@ -87,6 +55,8 @@ func cri(c clabernetesmanagertypes.Clabernetes) (string, error) {
 			nodeCRIs.Add(clabernetesconstants.KubernetesCRIContainerd)
 		case strings.HasPrefix(criVersion, clabernetesconstants.KubernetesCRICrio):
 			nodeCRIs.Add(clabernetesconstants.KubernetesCRICrio)
+		case strings.HasPrefix(criVersion, clabernetesconstants.KubernetesCRIDocker):
+			nodeCRIs.Add(clabernetesconstants.KubernetesCRIDocker)
 		default:
 			nodeCRIs.Add(clabernetesconstants.KubernetesCRIUnknown)
 		}
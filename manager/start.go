@@ -7,6 +7,7 @@ import (
 	clabernetescontrollers "github.com/srl-labs/clabernetes/controllers"
 	clabernetescontrollersimagerequest "github.com/srl-labs/clabernetes/controllers/imagerequest"
 	clabernetescontrollerstopology "github.com/srl-labs/clabernetes/controllers/topology"
+	clabernetescontrollerstopologysnapshot "github.com/srl-labs/clabernetes/controllers/topologysnapshot"
 	clabernetesutil "github.com/srl-labs/clabernetes/util"
 )
 
@@ -43,6 +44,7 @@ func (c *clabernetes) startLeading(ctx context.Context) {
 	controllersToRegisterFuncs := []clabernetescontrollers.NewController{
 		clabernetescontrollerstopology.NewController,
 		clabernetescontrollersimagerequest.NewController,
+		clabernetescontrollerstopologysnapshot.NewController,
 	}
 
 	for _, newF := range controllersToRegisterFuncs {
@@ -18,3 +18,9 @@ func getCertificatesSecret(c clabernetesmanagertypes.Clabernetes) (*k8scorev1.Se
 		metav1.GetOptions{},
 	)
 }
+
+// getHTTPServiceName returns the name of the service fronting the clabernetes manager http server
+// -- this is the service admission webhooks are served via.
+func getHTTPServiceName(c clabernetesmanagertypes.Clabernetes) string {
+	return fmt.Sprintf("%s-http", c.GetAppName())
+}
@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	namespace = "clabernetes"
+
+	// ImagePullResultSucceeded is the "result" label value for image pulls that completed.
+	ImagePullResultSucceeded = "succeeded"
+
+	// ImagePullResultIncomplete is the "result" label value for image pulls where we stopped
+	// watching the puller pod before it left the pending state.
+	ImagePullResultIncomplete = "incomplete"
+
+	// ImagePullResultFailed is the "result" label value for image pulls where the puller pod
+	// reported a pull failure (bad image name, auth failure, image not found and the like).
+	ImagePullResultFailed = "failed"
+)
+
+var (
+	reconcileErrors = prometheus.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "topology",
+			Name:      "reconcile_errors_total",
+			Help:      "Total number of topology reconcile errors by (sub) reconciler.",
+		},
+		[]string{"reconciler"},
+	)
+
+	topologyDeployingDuration = prometheus.NewHistogram( //nolint:gochecknoglobals
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "topology",
+			Name:      "deploying_duration_seconds",
+			Help: "Time between a topology entering the deploying state and all of its nodes " +
+				"reporting ready.",
+			// 30s -> ~4h
+			Buckets: prometheus.ExponentialBuckets(30, 2, 10), //nolint:mnd
+		},
+	)
+
+	imageRequestPullDuration = prometheus.NewHistogramVec( //nolint:gochecknoglobals
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "imagerequest",
+			Name:      "pull_duration_seconds",
+			Help:      "Time image puller pods took to pull an image, by result.",
+			// 5s -> ~40m
+			Buckets: prometheus.ExponentialBuckets(5, 2, 10), //nolint:mnd
+		},
+		[]string{"result"},
+	)
+)
+
+// Register registers all clabernetes manager collectors with the given registerer -- typically
+// the controller-runtime metrics registry so that clabernetes metrics are served alongside the
+// controller-runtime ones. The reader is used to list Topology objects when scraped, so it should
+// be a cache backed reader.
+func Register(registerer prometheus.Registerer, reader ctrlruntimeclient.Reader) error {
+	for _, collector := range []prometheus.Collector{
+		reconcileErrors,
+		topologyDeployingDuration,
+		imageRequestPullDuration,
+		newTopologyCollector(reader),
+	} {
+		err := registerer.Register(collector)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RecordReconcileError increments the reconcile error counter for the given reconciler.
+func RecordReconcileError(reconciler string) {
+	reconcileErrors.WithLabelValues(reconciler).Inc()
+}
+
+// RecordTopologyDeployed records the time a topology spent deploying (from entering the deploying
+// state until all nodes reported ready).
+func RecordTopologyDeployed(duration time.Duration) {
+	topologyDeployingDuration.Observe(duration.Seconds())
+}
+
+// RecordImagePull records the duration of an image puller pod with the given result.
+func RecordImagePull(duration time.Duration, result string) {
+	imageRequestPullDuration.WithLabelValues(result).Observe(duration.Seconds())
+}
@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	collectTimeout = 5 * time.Second
+
+	topologyStateUnknown = "unknown"
+)
+
+// topologyCollector is a prometheus collector that lists Topology objects at scrape time -- this
+// way we never have to worry about cleaning up series for deleted Topologies.
+type topologyCollector struct {
+	reader ctrlruntimeclient.Reader
+
+	topologies       *prometheus.Desc
+	nodeProbeStatus  *prometheus.Desc
+	collectionErrors *prometheus.Desc
+}
+
+func newTopologyCollector(reader ctrlruntimeclient.Reader) *topologyCollector {
+	return &topologyCollector{
+		reader: reader,
+		topologies: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "topologies"),
+			"Number of topologies by topology state.",
+			[]string{"state"},
+			nil,
+		),
+		nodeProbeStatus: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "topology", "node_probe_status"),
+			"Probe status of topology nodes, the series for the current status of each probe "+
+				"has a value of 1.",
+			[]string{"namespace", "topology", "node", "probe", "status"},
+			nil,
+		),
+		collectionErrors: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "topology", "collection_errors"),
+			"Set to 1 if listing topologies failed during this scrape.",
+			nil,
+			nil,
+		),
+	}
+}
+
+func (c *topologyCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.topologies
+	ch <- c.nodeProbeStatus
+	ch <- c.collectionErrors
+}
+
+func (c *topologyCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	topologies := &clabernetesapisv1alpha1.TopologyList{}
+
+	err := c.reader.List(ctx, topologies)
+	if err != nil {
+		ch <- prometheus.MustNewConstMetric(c.collectionErrors, prometheus.GaugeValue, 1)
+
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.collectionErrors, prometheus.GaugeValue, 0)
+
+	stateCounts := map[string]int{
+		string(clabernetesapisv1alpha1.TopologyStateDeploying):    0,
+		string(clabernetesapisv1alpha1.TopologyStateRunning):      0,
+		string(clabernetesapisv1alpha1.TopologyStateDegraded):     0,
+		string(clabernetesapisv1alpha1.TopologyStateDeployFailed): 0,
+		topologyStateUnknown: 0,
+	}
+
+	for idx := range topologies.Items {
+		topology := &topologies.Items[idx]
+
+		state := string(topology.Status.TopologyState)
+		if state == "" {
+			state = topologyStateUnknown
+		}
+
+		stateCounts[state]++
+
+		for nodeName, probeStatuses := range topology.Status.NodeProbeStatuses {
+			for probe, status := range map[string]clabernetesapisv1alpha1.NodeProbeStatus{
+				"startup":   probeStatuses.StartupProbe,
+				"readiness": probeStatuses.ReadinessProbe,
+				"liveness":  probeStatuses.LivenessProbe,
+			} {
+				ch <- prometheus.MustNewConstMetric(
+					c.nodeProbeStatus,
+					prometheus.GaugeValue,
+					1,
+					topology.Namespace,
+					topology.Name,
+					nodeName,
+					probe,
+					string(status),
+				)
+			}
+		}
+	}
+
+	for state, count := range stateCounts {
+		ch <- prometheus.MustNewConstMetric(
+			c.topologies,
+			prometheus.GaugeValue,
+			float64(count),
+			state,
+		)
+	}
+}
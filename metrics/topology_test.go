@@ -0,0 +1,109 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	clabernetesapisv1alpha1 "github.com/srl-labs/clabernetes/apis/v1alpha1"
+	clabernetesmetrics "github.com/srl-labs/clabernetes/metrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	ctrlruntimeclientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestTopologyCollector(t *testing.T) {
+	scheme := apimachineryruntime.NewScheme()
+
+	err := clabernetesapisv1alpha1.AddToScheme(scheme)
+	if err != nil {
+		t.Fatalf("failed adding clabernetes types to scheme, error: %s", err)
+	}
+
+	fakeClient := ctrlruntimeclientfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{Name: "topo1", Namespace: "clabernetes"},
+				Status: clabernetesapisv1alpha1.TopologyStatus{
+					TopologyState: clabernetesapisv1alpha1.TopologyStateRunning,
+					NodeProbeStatuses: map[string]clabernetesapisv1alpha1.NodeProbeStatuses{
+						"srl1": {
+							StartupProbe:   clabernetesapisv1alpha1.NodeProbeStatusPassing,
+							ReadinessProbe: clabernetesapisv1alpha1.NodeProbeStatusPassing,
+							LivenessProbe:  clabernetesapisv1alpha1.NodeProbeStatusDisabled,
+						},
+					},
+				},
+			},
+			&clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{Name: "topo2", Namespace: "clabernetes"},
+				Status: clabernetesapisv1alpha1.TopologyStatus{
+					TopologyState: clabernetesapisv1alpha1.TopologyStateRunning,
+				},
+			},
+			&clabernetesapisv1alpha1.Topology{
+				ObjectMeta: metav1.ObjectMeta{Name: "topo3", Namespace: "clabernetes"},
+			},
+		).
+		Build()
+
+	registry := prometheus.NewRegistry()
+
+	err = clabernetesmetrics.Register(registry, fakeClient)
+	if err != nil {
+		t.Fatalf("failed registering metrics, error: %s", err)
+	}
+
+	clabernetesmetrics.RecordReconcileError("deployments")
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("failed gathering metrics, error: %s", err)
+	}
+
+	actualStates := map[string]float64{}
+
+	var actualProbeSeries int
+
+	var actualReconcileErrors float64
+
+	for _, family := range families {
+		switch family.GetName() {
+		case "clabernetes_topologies":
+			for _, metric := range family.GetMetric() {
+				actualStates[metric.GetLabel()[0].GetValue()] = metric.GetGauge().GetValue()
+			}
+		case "clabernetes_topology_node_probe_status":
+			actualProbeSeries = len(family.GetMetric())
+		case "clabernetes_topology_reconcile_errors_total":
+			actualReconcileErrors = family.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+
+	expectedStates := map[string]float64{
+		"deploying":    0,
+		"running":      2,
+		"degraded":     0,
+		"deployfailed": 0,
+		"unknown":      1,
+	}
+
+	for state, expected := range expectedStates {
+		if actualStates[state] != expected {
+			t.Fatalf(
+				"expected %v topologies in state %q, got %v",
+				expected,
+				state,
+				actualStates[state],
+			)
+		}
+	}
+
+	if actualProbeSeries != 3 {
+		t.Fatalf("expected 3 node probe status series, got %d", actualProbeSeries)
+	}
+
+	if actualReconcileErrors != 1 {
+		t.Fatalf("expected 1 reconcile error, got %v", actualReconcileErrors)
+	}
+}
@@ -1,7 +1,7 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
 import type { Options as ClientOptions, TDataShape, Client } from '@hey-api/client-fetch';
-import type { ListClabernetesContainerlabDevV1Alpha1ConnectivityForAllNamespacesData, ListClabernetesContainerlabDevV1Alpha1ConnectivityForAllNamespacesResponse, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedConnectivityData, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedConnectivityResponse, ListClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, ListClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, CreateClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, CreateClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, DeleteClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, DeleteClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, ReadClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, ReadClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, PatchClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, PatchClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, ListClabernetesContainerlabDevV1Alpha1ImagerequestForAllNamespacesData, ListClabernetesContainerlabDevV1Alpha1ImagerequestForAllNamespacesResponse, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedImagerequestData, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedImagerequestResponse, ListClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, ListClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, CreateClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, CreateClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, DeleteClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, DeleteClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, ReadClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, ReadClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, PatchClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, PatchClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, ListClabernetesContainerlabDevV1Alpha1ConfigForAllNamespacesData, ListClabernetesContainerlabDevV1Alpha1ConfigForAllNamespacesResponse, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedConfigData, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedConfigResponse, ListClabernetesContainerlabDevV1Alpha1NamespacedConfigData, ListClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, CreateClabernetesContainerlabDevV1Alpha1NamespacedConfigData, CreateClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, DeleteClabernetesContainerlabDevV1Alpha1NamespacedConfigData, DeleteClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, ReadClabernetesContainerlabDevV1Alpha1NamespacedConfigData, ReadClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, PatchClabernetesContainerlabDevV1Alpha1NamespacedConfigData, PatchClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedConfigData, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, ListClabernetesContainerlabDevV1Alpha1TopologyForAllNamespacesData, ListClabernetesContainerlabDevV1Alpha1TopologyForAllNamespacesResponse, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologyData, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologyResponse, ListClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, ListClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse } from './types.gen';
+import type { ListClabernetesContainerlabDevV1Alpha1ConnectivityForAllNamespacesData, ListClabernetesContainerlabDevV1Alpha1ConnectivityForAllNamespacesResponse, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedConnectivityData, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedConnectivityResponse, ListClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, ListClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, CreateClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, CreateClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, DeleteClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, DeleteClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, ReadClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, ReadClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, PatchClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, PatchClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedConnectivityData, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedConnectivityResponse, ListClabernetesContainerlabDevV1Alpha1ImagerequestForAllNamespacesData, ListClabernetesContainerlabDevV1Alpha1ImagerequestForAllNamespacesResponse, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedImagerequestData, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedImagerequestResponse, ListClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, ListClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, CreateClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, CreateClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, DeleteClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, DeleteClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, ReadClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, ReadClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, PatchClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, PatchClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedImagerequestData, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedImagerequestResponse, ListClabernetesContainerlabDevV1Alpha1ConfigForAllNamespacesData, ListClabernetesContainerlabDevV1Alpha1ConfigForAllNamespacesResponse, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedConfigData, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedConfigResponse, ListClabernetesContainerlabDevV1Alpha1NamespacedConfigData, ListClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, CreateClabernetesContainerlabDevV1Alpha1NamespacedConfigData, CreateClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, DeleteClabernetesContainerlabDevV1Alpha1NamespacedConfigData, DeleteClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, ReadClabernetesContainerlabDevV1Alpha1NamespacedConfigData, ReadClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, PatchClabernetesContainerlabDevV1Alpha1NamespacedConfigData, PatchClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedConfigData, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedConfigResponse, ListClabernetesContainerlabDevV1Alpha1TopologyForAllNamespacesData, ListClabernetesContainerlabDevV1Alpha1TopologyForAllNamespacesResponse, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologyData, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologyResponse, ListClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, ListClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologyData, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse, ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesData, ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesResponse, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotData, DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotResponse, ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse } from './types.gen';
 import { client as _heyApiClient } from './client.gen';
 
 export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends boolean = boolean> = ClientOptions<TData, ThrowOnError> & {
@@ -400,4 +400,100 @@ export const replaceClabernetesContainerlabDevV1Alpha1NamespacedTopology = <Thro
             ...options?.headers
         }
     });
+};
+
+/**
+ * list objects of kind Topologysnapshot
+ */
+export const listClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespaces = <ThrowOnError extends boolean = false>(options?: Options<ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesResponse, unknown, ThrowOnError>({
+        url: '/apis/clabernetes.containerlab.dev/v1alpha1/topologysnapshots',
+        ...options
+    });
+};
+
+/**
+ * delete collection of Topologysnapshot
+ */
+export const deleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshot = <ThrowOnError extends boolean = false>(options: Options<DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotResponse, unknown, ThrowOnError>({
+        url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots',
+        ...options
+    });
+};
+
+/**
+ * list objects of kind Topologysnapshot
+ */
+export const listClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshot = <ThrowOnError extends boolean = false>(options: Options<ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, unknown, ThrowOnError>({
+        url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots',
+        ...options
+    });
+};
+
+/**
+ * create a Topologysnapshot
+ */
+export const createClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshot = <ThrowOnError extends boolean = false>(options: Options<CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, unknown, ThrowOnError>({
+        url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * delete a Topologysnapshot
+ */
+export const deleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshot = <ThrowOnError extends boolean = false>(options: Options<DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, unknown, ThrowOnError>({
+        url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots/{name}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * read the specified Topologysnapshot
+ */
+export const readClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshot = <ThrowOnError extends boolean = false>(options: Options<ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, unknown, ThrowOnError>({
+        url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots/{name}',
+        ...options
+    });
+};
+
+/**
+ * partially update the specified Topologysnapshot
+ */
+export const patchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshot = <ThrowOnError extends boolean = false>(options: Options<PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, unknown, ThrowOnError>({
+        url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots/{name}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/apply-patch+yaml',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * replace the specified Topologysnapshot
+ */
+export const replaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshot = <ThrowOnError extends boolean = false>(options: Options<ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).put<ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse, unknown, ThrowOnError>({
+        url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots/{name}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
 };
\ No newline at end of file
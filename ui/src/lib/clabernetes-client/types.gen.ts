@@ -369,6 +369,28 @@ export type ClabernetesContainerlabDevConnectivityV1Alpha1 = {
                  * Destination is the destination service to connect to (qualified k8s service name).
                  */
                 destination: string;
+                /**
+                 * Impairment holds the impairments to apply to the local interface of this tunnel, if any.
+                 */
+                impairment?: {
+                    /**
+                     * Delay is the delay (latency) to add to packets, as a go duration string, for example "50ms".
+                     */
+                    delay?: string;
+                    /**
+                     * Jitter is the variation of the delay, as a go duration string, for example "5ms". Jitter is
+                     * only applied if a delay is set.
+                     */
+                    jitter?: string;
+                    /**
+                     * Loss is the percentage of packets to drop, for example "0.5" to drop 0.5% of packets.
+                     */
+                    loss?: string;
+                    /**
+                     * Rate is the rate limit in kbit/s, unset (or zero) means no rate limit.
+                     */
+                    rate?: number;
+                };
                 /**
                  * LocalInterface is the local termination of this tunnel.
                  */
@@ -389,10 +411,34 @@ export type ClabernetesContainerlabDevConnectivityV1Alpha1 = {
                  * tunnel.
                  */
                 remoteNode: string;
+                /**
+                 * State is the desired administrative state of the local interface of this tunnel, either "up"
+                 * or "down". An empty state means "up".
+                 */
+                state?: 'up' | 'down';
                 /**
                  * TunnelID is the id number of the tunnel (vnid or segment id).
                  */
                 tunnelID: number;
+                /**
+                 * WireGuard holds the wireguard peering information of this tunnel, only set when the
+                 * topology uses "wireguard" connectivity.
+                 */
+                wireGuard?: {
+                    /**
+                     * LocalAddress is the address of the wireguard interface of the local launcher.
+                     */
+                    localAddress: string;
+                    /**
+                     * RemoteAddress is the address of the wireguard interface of the remote launcher, this is
+                     * the address the tunnel is terminated on in the remote launcher.
+                     */
+                    remoteAddress: string;
+                    /**
+                     * RemotePublicKey is the (base64 encoded) wireguard public key of the remote launcher.
+                     */
+                    remotePublicKey: string;
+                };
             }>;
         };
     };
@@ -400,7 +446,60 @@ export type ClabernetesContainerlabDevConnectivityV1Alpha1 = {
      * ConnectivityStatus is the status for a Connectivity resource.
      */
     status?: {
-        [key: string]: unknown;
+        /**
+         * PointToPointTunnels holds the observed state of the point-to-point tunnels as reported by
+         * the launchers. The mapping is nodeName (i.e. srl1) -> p2p tunnel status.
+         */
+        pointToPointTunnels?: {
+            [key: string]: Array<{
+                /**
+                 * InterfaceExists indicates if the (launcher side) local interface of this tunnel exists.
+                 */
+                interfaceExists: boolean;
+                /**
+                 * LastUpdateTime is the time the launcher last reported the state of this tunnel.
+                 */
+                lastUpdateTime?: string;
+                /**
+                 * LocalInterface is the local termination of this tunnel.
+                 */
+                localInterface: string;
+                /**
+                 * LocalNode is the local node (in the clabernetes topology) of this tunnel.
+                 */
+                localNode?: string;
+                /**
+                 * OperState is the operational state of the local interface of this tunnel as reported by the
+                 * kernel, for example "up", "down" or "unknown".
+                 */
+                operState?: string;
+                /**
+                 * RxBytes is the number of bytes received on the local interface of this tunnel.
+                 */
+                rxBytes?: number;
+                /**
+                 * RxPackets is the number of packets received on the local interface of this tunnel.
+                 */
+                rxPackets?: number;
+                /**
+                 * State is the administrative state, either "up" or "down", last applied to the local
+                 * interface of this tunnel.
+                 */
+                state: string;
+                /**
+                 * TunnelID is the id number of the tunnel (vnid or segment id).
+                 */
+                tunnelID: number;
+                /**
+                 * TxBytes is the number of bytes transmitted on the local interface of this tunnel.
+                 */
+                txBytes?: number;
+                /**
+                 * TxPackets is the number of packets transmitted on the local interface of this tunnel.
+                 */
+                txPackets?: number;
+            }>;
+        };
     };
 };
 
@@ -488,11 +587,76 @@ export type ClabernetesContainerlabDevImagerequestV1Alpha1 = {
          * works, and i can go watch the cri images on this node now".
          */
         accepted: boolean;
+        /**
+         * Attempts is the number of times the ImageRequest controller has attempted to pull the image
+         * (spawned a puller pod) for this image request.
+         */
+        attempts?: number;
         /**
          * Complete indicates that the ImageRequest controller has seen that the puller pod has done its
          * job and that the image has been pulled onto the requested node.
          */
         complete: boolean;
+        /**
+         * CompletionTime is the time the image request was completed, either because the image was
+         * pulled or because all pull attempts failed. The image request is deleted once its time to
+         * live has passed since this time.
+         */
+        completionTime?: string;
+        /**
+         * Conditions is a list of conditions for the image request, the "Pulled" condition reflects
+         * the state of the image pull on the requested node.
+         */
+        conditions?: Array<{
+            /**
+             * lastTransitionTime is the last time the condition transitioned from one status to another.
+             * This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+             */
+            lastTransitionTime: string;
+            /**
+             * message is a human readable message indicating details about the transition.
+             * This may be an empty string.
+             */
+            message: string;
+            /**
+             * observedGeneration represents the .metadata.generation that the condition was set based upon.
+             * For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+             * with respect to the current state of the instance.
+             */
+            observedGeneration?: number;
+            /**
+             * reason contains a programmatic identifier indicating the reason for the condition's last transition.
+             * Producers of specific condition types may define expected values and meanings for this field,
+             * and whether the values are considered a guaranteed API.
+             * The value should be a CamelCase string.
+             * This field may not be empty.
+             */
+            reason: string;
+            /**
+             * status of the condition, one of True, False, Unknown.
+             */
+            status: 'True' | 'False' | 'Unknown';
+            /**
+             * type of condition in CamelCase or in foo.example.com/CamelCase.
+             */
+            type: string;
+        }>;
+        /**
+         * FailureMessage is the message accompanying the failure reason, this usually holds the actual
+         * registry error -- for example the image not being found or the registry refusing our
+         * credentials.
+         */
+        failureMessage?: string;
+        /**
+         * FailureReason is the reason the last pull attempt failed as reported by the kubelet for the
+         * puller pod container, for example "ErrImagePull" or "InvalidImageName".
+         */
+        failureReason?: string;
+        /**
+         * NextAttemptTime is the time of the next pull attempt after a failed attempt that is going to
+         * be retried, the ImageRequest controller backs off until then.
+         */
+        nextAttemptTime?: string;
     };
 };
 
@@ -813,14 +977,16 @@ export type ClabernetesContainerlabDevConfigV1Alpha1 = {
              * clusters -- however in the latter case, make sure that if you are using image pull through
              * that clabernetes workloads are only run on the nodes of the cri kind specified here!
              */
-            criKindOverride?: 'containerd';
+            criKindOverride?: 'containerd' | 'crio' | 'docker';
             /**
              * CRISockOverride allows for overriding the path of the CRI sock that is mounted in the
              * launcher pods (if/when image pull through mode is auto or always). This can be useful if,
              * for example, the CRI sock is in a "non-standard" location like K3s which puts the containerd
              * sock at `/run/k3s/containerd/containerd.sock` rather than the "normal" (whatever that means)
-             * location of `/run/containerd/containerd.sock`. The value must end with "containerd.sock" for
-             * now, in the future maybe crio support will be added.
+             * location of `/run/containerd/containerd.sock`. The value must end with "containerd.sock" or
+             * "docker.sock" -- or, for crio, it must be the containers/storage directory (ending with
+             * "storage", typically `/var/lib/containers/storage`) as crio images are read directly from
+             * the node's image storage.
              */
             criSockOverride?: string;
             /**
@@ -842,6 +1008,20 @@ export type ClabernetesContainerlabDevConfigV1Alpha1 = {
              * /etc/docker and docker will be expecting the config at /etc/docker/daemon.json.
              */
             dockerDaemonConfig?: string;
+            /**
+             * ImageCacheHostPath enables the node image cache when set -- this is the path on the
+             * kubernetes nodes that launchers use to share node images exported from the CRI (if/when
+             * image pull through mode is auto or always). Rather than each launcher exporting its own copy
+             * of an image, the first launcher on a kubernetes node exports it to the cache (keyed by image
+             * id) and any other launchers on that node reuse it. Cached images unused for seven days are
+             * evicted. For example: `/var/lib/clabernetes/image-cache`.
+             */
+            imageCacheHostPath?: string;
+            /**
+             * Prewarm sets the global default for pre-pulling ("prewarming") topology images onto the
+             * kubernetes nodes the launchers could be scheduled on before the launchers are deployed.
+             */
+            prewarm?: boolean;
             /**
              * PullThroughOverride allows for overriding the image pull through mode for this
              * particular topology.
@@ -942,9 +1122,13 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
          * Connectivity defines the type of connectivity to use between nodes in the topology. The
          * default behavior is to use vxlan tunnels, alternatively you can enable a more experimental
          * "slurpeeth" connectivity flavor that stuffs traffic into tcp tunnels to avoid any vxlan mtu
-         * and/or fragmentation challenges.
+         * and/or fragmentation challenges, "vxlan-netlink" which sets up the same vxlan tunnels as
+         * "vxlan" but natively via netlink rather than by shelling out to containerlab, "geneve" which
+         * sets up geneve tunnels (via netlink), or "wireguard" which carries vxlan tunnels through
+         * encrypted wireguard interfaces between the launchers (keys are generated per topology and
+         * stored in a secret).
          */
-        connectivity?: 'vxlan' | 'slurpeeth';
+        connectivity?: 'vxlan' | 'slurpeeth' | 'vxlan-netlink' | 'geneve' | 'wireguard';
         /**
          * Definition defines the actual set of nodes (network ones, not k8s ones!) that this Topology
          * CR represents. Historically, and probably most often, this means Topology holds a "normal"
@@ -1156,6 +1340,28 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
              * not satisfy enum of course.
              */
             launcherLogLevel?: 'disabled' | 'critical' | 'warn' | 'info' | 'debug';
+            /**
+             * LauncherRuntime sets the container runtime the launcher uses to run the containerlab
+             * sub-topology. The default, "docker", runs a nested docker daemon in each launcher. "podman"
+             * instead uses containerlab's podman runtime so no docker daemon is started at all; this avoids
+             * docker-in-docker but note that the launcher still needs enough privileges to create the
+             * node containers and their network interfaces.
+             */
+            launcherRuntime?: 'docker' | 'podman';
+            /**
+             * NodeGroups is a mapping of node name to a list of (other) node names that should be
+             * co-located with that node -- that is, deployed in the same launcher pod. The nodes of a group
+             * are rendered into a single sub-topology (and a single launcher Deployment named after the
+             * "primary" node, the key of the mapping); links between the nodes of a group stay native veth
+             * links, only links to nodes outside the group become tunnels. This is useful for traffic-heavy
+             * node pairs such as a traffic generator and its DUT. Only the primary node of a group is
+             * exposed, a node may only be part of one group, and bridge nodes cannot be grouped. Nodes that
+             * share a network namespace (network-mode "container:<primary>") are always co-located with
+             * their primary and do not need to be listed here. Only applies to containerlab topologies.
+             */
+            nodeGroups?: {
+                [key: string]: Array<string>;
+            };
             /**
              * Persistence holds configurations relating to persisting each nodes working containerlab
              * directory.
@@ -1174,6 +1380,12 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
                  * a mounted PVC.
                  */
                 enabled: boolean;
+                /**
+                 * SaveOnTeardown, when true, causes each launcher to run "containerlab save" (saving the
+                 * running configuration of the node into the persisted lab directory) before destroying the
+                 * lab when the Topology is deleted. This has no effect if persistence is not enabled.
+                 */
+                saveOnTeardown?: boolean;
                 /**
                  * StorageClassName is the storage class to set in the PVC -- if not provided this will be left
                  * empty which will end up using your default storage class. Note that currently we assume you
@@ -1245,6 +1457,16 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
                     };
                 };
             };
+            /**
+             * RestoreFromSnapshot is the name of a (completed) TopologySnapshot in the namespace of the
+             * topology to restore the node configurations from. When set, the configuration captured for
+             * each node in the snapshot is mounted in the launcher and used as the (enforced)
+             * startup-config of the node, and the launchers of the affected nodes are restarted. Changing
+             * the snapshot restores the nodes to that snapshot, clearing it restarts the nodes with their
+             * original startup-config. Nodes are matched by name, nodes that were not captured in the
+             * snapshot are left untouched.
+             */
+            restoreFromSnapshot?: string;
             /**
              * Scheduling holds information about how the launcher pod(s) should be configured with respect
              * to "scheduling" things (affinity/node selector/tolerations).
@@ -1334,10 +1556,16 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
              * you may want to do this if you want to tickle the pods by pod name directly for some
              * reason while not having extra services floating around.
              * - ClusterIP: a clusterip service is created so you can hit that service name for the pods.
+             * - Headless: a headless service (clusterIP: None) is created. This is useful when you don't
+             * need load-balancing or a single service IP but want to directly connect to pods via
+             * DNS records that return pod IPs.
              * - LoadBalancer: (default) creates a load balancer service so you can access your pods from
              * outside the cluster. this is/was the only behavior up to v0.2.4.
+             * - SharedLoadBalancer: creates a single load balancer service for the whole topology, each
+             * exposed node port is mapped to a distinct port on that load balancer -- the mapping
+             * is reported in the topology status exposed ports. Nodes also get a clusterip service.
              */
-            exposeType?: 'None' | 'ClusterIP' | 'LoadBalancer';
+            exposeType?: 'None' | 'ClusterIP' | 'Headless' | 'LoadBalancer' | 'SharedLoadBalancer';
         };
         /**
          * ImagePull holds configurations relevant to how clabernetes launcher pods handle pulling
@@ -1364,6 +1592,14 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
              * pods.
              */
             insecureRegistries?: Array<string>;
+            /**
+             * Prewarm enables pre-pulling ("prewarming") the images of this topology. When enabled, the
+             * controller requests every image of the topology to be pulled onto all kubernetes nodes that
+             * the launchers using that image could be scheduled on, before the launchers are deployed.
+             * Pre-pulling is done via the image pull through machinery, so it has no effect if image pull
+             * through mode is never. If unset, the global config value is used.
+             */
+            prewarm?: boolean;
             /**
              * PullSecrets allows for providing secret(s) to use when pulling the image. This is only
              * applicable *if* ImagePullThrough mode is auto or always. The secret is used by the launcher
@@ -1381,6 +1617,59 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
              */
             pullThroughOverride?: 'auto' | 'always' | 'never';
         };
+        /**
+         * LinkImpairments is a list of netem style impairments (delay, jitter, loss, rate) to apply to
+         * links of the topology. Impairments are applied by the launchers on both sides of a link (to
+         * the traffic each node sends over the link) and are updated live when changed, the launchers
+         * are not restarted. Only links between nodes in different launchers can be impaired, links
+         * between nodes in the same launcher (or to the host) are left untouched.
+         */
+        linkImpairments?: Array<{
+            /**
+             * Endpoints are the two endpoints ("node:interface") of the link to impair, exactly as in the
+             * topology definition. The order of the endpoints does not matter.
+             */
+            endpoints: Array<string>;
+            /**
+             * Impairment is the impairment to apply to both directions of the link.
+             */
+            impairment: {
+                /**
+                 * Delay is the delay (latency) to add to packets, as a go duration string, for example "50ms".
+                 */
+                delay?: string;
+                /**
+                 * Jitter is the variation of the delay, as a go duration string, for example "5ms". Jitter is
+                 * only applied if a delay is set.
+                 */
+                jitter?: string;
+                /**
+                 * Loss is the percentage of packets to drop, for example "0.5" to drop 0.5% of packets.
+                 */
+                loss?: string;
+                /**
+                 * Rate is the rate limit in kbit/s, unset (or zero) means no rate limit.
+                 */
+                rate?: number;
+            };
+        }>;
+        /**
+         * LinkStates is a list of administrative state overrides for links of the topology, setting
+         * a link "down" sets the interfaces of both sides of the link down without redeploying
+         * anything, removing the override (or setting it "up") brings the link back up. Like
+         * impairments, only links between nodes in different launchers can be set down.
+         */
+        linkStates?: Array<{
+            /**
+             * Endpoints are the two endpoints ("node:interface") of the link, exactly as in the topology
+             * definition. The order of the endpoints does not matter.
+             */
+            endpoints: Array<string>;
+            /**
+             * State is the desired administrative state of the link, either "up" or "down".
+             */
+            state: 'up' | 'down';
+        }>;
         /**
          * Naming tells the clabernetes controller how it should name resources it creates -- that is
          * whether it should include the containerlab topology name as a prefix on resources spawned
@@ -1422,22 +1711,154 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
              */
             nodeProbeConfigurations?: {
                 [key: string]: {
+                    /**
+                     * ExecProbeConfiguration defines an exec probe.
+                     */
+                    execProbeConfiguration?: {
+                        /**
+                         * Command is the command (and its arguments) to execute in the node container.
+                         */
+                        command: Array<string>;
+                        /**
+                         * ExpectedOutput is a regular expression the (combined stdout and stderr) output of the command
+                         * must match.
+                         */
+                        expectedOutput?: string;
+                    };
+                    /**
+                     * GNMIProbeConfiguration defines a gNMI probe.
+                     */
+                    gnmiProbeConfiguration?: {
+                        /**
+                         * CredentialsSecret is the name of a Secret in the namespace of the Topology holding the
+                         * credentials to use for auth. The "username" and "password" keys (as used by the
+                         * kubernetes.io/basic-auth Secret type) are used if present, the username and password set
+                         * inline take precedence over the ones in the Secret.
+                         */
+                        credentialsSecret?: string;
+                        /**
+                         * Insecure disables TLS, meaning the probe uses a plaintext connection.
+                         */
+                        insecure?: boolean;
+                        /**
+                         * Password is the password to use for auth. Note that this ends up in plain text in the
+                         * launcher Deployment, prefer CredentialsSecret.
+                         */
+                        password?: string;
+                        /**
+                         * Port is the port of the gNMI server.
+                         */
+                        port: number;
+                        /**
+                         * Username is the username to use for auth, if unset (and not set in CredentialsSecret) no
+                         * credentials are sent.
+                         */
+                        username?: string;
+                    };
+                    /**
+                     * HTTPProbeConfiguration defines an HTTP(S) probe.
+                     */
+                    httpProbeConfiguration?: {
+                        /**
+                         * ExpectedStatusCode is the status code the response must have, if unset any 2xx or 3xx status
+                         * code is considered successful.
+                         */
+                        expectedStatusCode?: number;
+                        /**
+                         * Path is the path to request, defaults to "/".
+                         */
+                        path?: string;
+                        /**
+                         * Port is the port to send the request to.
+                         */
+                        port: number;
+                        /**
+                         * Scheme is the scheme to use for the request, defaults to "HTTP".
+                         */
+                        scheme?: 'HTTP' | 'HTTPS';
+                    };
+                    /**
+                     * InitialDelaySeconds is the delay before the startup probe starts checking the node status,
+                     * defaults to 60 seconds. Lightweight nodes that are ready within seconds may want to set this
+                     * to a (much) lower value.
+                     */
+                    initialDelaySeconds?: number;
+                    /**
+                     * IntervalSeconds is the interval at which the launcher probes the node and at which the
+                     * startup/readiness (and if enabled liveness) probes check the result, defaults to 20 seconds.
+                     */
+                    intervalSeconds?: number;
+                    /**
+                     * LivenessFailureThreshold is the number of consecutive failed checks (after the node has
+                     * started) after which the node is considered dead and the LivenessRestartPolicy is applied.
+                     * Unset (or zero) means there is no liveness probe and the node is never restarted due to
+                     * failing checks.
+                     */
+                    livenessFailureThreshold?: number;
+                    /**
+                     * LivenessRestartPolicy is what happens when the liveness probe fails, it only has an effect
+                     * if LivenessFailureThreshold is set. "never" only reports the liveness status, "node"
+                     * redeploys the containerlab node(s) of the launcher (and re-applies their tunnels) and
+                     * "launcher" (the default) restarts the launcher (and so the node along with it) via a k8s
+                     * liveness probe.
+                     */
+                    livenessRestartPolicy?: 'never' | 'node' | 'launcher';
+                    /**
+                     * NETCONFProbeConfiguration defines a NETCONF probe.
+                     */
+                    netconfProbeConfiguration?: {
+                        /**
+                         * CredentialsSecret is the name of a Secret in the namespace of the Topology holding the
+                         * credentials to use for auth. The "username" and "password" keys (as used by the
+                         * kubernetes.io/basic-auth Secret type) are used if present, the username and password set
+                         * inline take precedence over the ones in the Secret.
+                         */
+                        credentialsSecret?: string;
+                        /**
+                         * Password is the password to use for auth, required unless CredentialsSecret is set. Note
+                         * that this ends up in plain text in the launcher Deployment, prefer CredentialsSecret.
+                         */
+                        password?: string;
+                        /**
+                         * Port is an optional override (of course default is 830).
+                         */
+                        port?: number;
+                        /**
+                         * Username is the username to use for auth, required unless CredentialsSecret is set.
+                         */
+                        username?: string;
+                    };
+                    /**
+                     * ReadinessFailureThreshold is the number of consecutive failed checks after which the node is
+                     * reported as not ready, defaults to 3.
+                     */
+                    readinessFailureThreshold?: number;
                     /**
                      * SSHProbeConfiguration defines an SSH probe.
                      */
                     sshProbeConfiguration?: {
                         /**
-                         * Password is the password to use for auth.
+                         * CredentialsSecret is the name of a Secret in the namespace of the Topology holding the
+                         * credentials to use for auth. The "username", "password" and "ssh-privatekey" keys (as used
+                         * by the kubernetes.io/basic-auth and kubernetes.io/ssh-auth Secret types) are used if
+                         * present, the username and password set inline take precedence over the ones in the Secret.
+                         * The values are injected into the launcher via environment variable references and so never
+                         * show up in the launcher Deployment itself.
                          */
-                        password: string;
+                        credentialsSecret?: string;
+                        /**
+                         * Password is the password to use for auth, required unless CredentialsSecret is set. Note
+                         * that this ends up in plain text in the launcher Deployment, prefer CredentialsSecret.
+                         */
+                        password?: string;
                         /**
                          * Port is an optional override (of course default is 22).
                          */
                         port?: number;
                         /**
-                         * Username is the username to use for auth.
+                         * Username is the username to use for auth, required unless CredentialsSecret is set.
                          */
-                        username: string;
+                        username?: string;
                     };
                     /**
                      * StartupSeconds is the total amount of seconds to allow for the node to start. This defaults
@@ -1461,28 +1882,164 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
                          */
                         port: number;
                     };
+                    /**
+                     * TimeoutSeconds is the timeout for the launcher probe attempts, defaults to 5 seconds.
+                     */
+                    timeoutSeconds?: number;
                 };
             };
             /**
              * ProbeConfiguration is the default probe configuration for the Topology.
              */
             probeConfiguration?: {
+                /**
+                 * ExecProbeConfiguration defines an exec probe.
+                 */
+                execProbeConfiguration?: {
+                    /**
+                     * Command is the command (and its arguments) to execute in the node container.
+                     */
+                    command: Array<string>;
+                    /**
+                     * ExpectedOutput is a regular expression the (combined stdout and stderr) output of the command
+                     * must match.
+                     */
+                    expectedOutput?: string;
+                };
+                /**
+                 * GNMIProbeConfiguration defines a gNMI probe.
+                 */
+                gnmiProbeConfiguration?: {
+                    /**
+                     * CredentialsSecret is the name of a Secret in the namespace of the Topology holding the
+                     * credentials to use for auth. The "username" and "password" keys (as used by the
+                     * kubernetes.io/basic-auth Secret type) are used if present, the username and password set
+                     * inline take precedence over the ones in the Secret.
+                     */
+                    credentialsSecret?: string;
+                    /**
+                     * Insecure disables TLS, meaning the probe uses a plaintext connection.
+                     */
+                    insecure?: boolean;
+                    /**
+                     * Password is the password to use for auth. Note that this ends up in plain text in the
+                     * launcher Deployment, prefer CredentialsSecret.
+                     */
+                    password?: string;
+                    /**
+                     * Port is the port of the gNMI server.
+                     */
+                    port: number;
+                    /**
+                     * Username is the username to use for auth, if unset (and not set in CredentialsSecret) no
+                     * credentials are sent.
+                     */
+                    username?: string;
+                };
+                /**
+                 * HTTPProbeConfiguration defines an HTTP(S) probe.
+                 */
+                httpProbeConfiguration?: {
+                    /**
+                     * ExpectedStatusCode is the status code the response must have, if unset any 2xx or 3xx status
+                     * code is considered successful.
+                     */
+                    expectedStatusCode?: number;
+                    /**
+                     * Path is the path to request, defaults to "/".
+                     */
+                    path?: string;
+                    /**
+                     * Port is the port to send the request to.
+                     */
+                    port: number;
+                    /**
+                     * Scheme is the scheme to use for the request, defaults to "HTTP".
+                     */
+                    scheme?: 'HTTP' | 'HTTPS';
+                };
+                /**
+                 * InitialDelaySeconds is the delay before the startup probe starts checking the node status,
+                 * defaults to 60 seconds. Lightweight nodes that are ready within seconds may want to set this
+                 * to a (much) lower value.
+                 */
+                initialDelaySeconds?: number;
+                /**
+                 * IntervalSeconds is the interval at which the launcher probes the node and at which the
+                 * startup/readiness (and if enabled liveness) probes check the result, defaults to 20 seconds.
+                 */
+                intervalSeconds?: number;
+                /**
+                 * LivenessFailureThreshold is the number of consecutive failed checks (after the node has
+                 * started) after which the node is considered dead and the LivenessRestartPolicy is applied.
+                 * Unset (or zero) means there is no liveness probe and the node is never restarted due to
+                 * failing checks.
+                 */
+                livenessFailureThreshold?: number;
+                /**
+                 * LivenessRestartPolicy is what happens when the liveness probe fails, it only has an effect
+                 * if LivenessFailureThreshold is set. "never" only reports the liveness status, "node"
+                 * redeploys the containerlab node(s) of the launcher (and re-applies their tunnels) and
+                 * "launcher" (the default) restarts the launcher (and so the node along with it) via a k8s
+                 * liveness probe.
+                 */
+                livenessRestartPolicy?: 'never' | 'node' | 'launcher';
+                /**
+                 * NETCONFProbeConfiguration defines a NETCONF probe.
+                 */
+                netconfProbeConfiguration?: {
+                    /**
+                     * CredentialsSecret is the name of a Secret in the namespace of the Topology holding the
+                     * credentials to use for auth. The "username" and "password" keys (as used by the
+                     * kubernetes.io/basic-auth Secret type) are used if present, the username and password set
+                     * inline take precedence over the ones in the Secret.
+                     */
+                    credentialsSecret?: string;
+                    /**
+                     * Password is the password to use for auth, required unless CredentialsSecret is set. Note
+                     * that this ends up in plain text in the launcher Deployment, prefer CredentialsSecret.
+                     */
+                    password?: string;
+                    /**
+                     * Port is an optional override (of course default is 830).
+                     */
+                    port?: number;
+                    /**
+                     * Username is the username to use for auth, required unless CredentialsSecret is set.
+                     */
+                    username?: string;
+                };
+                /**
+                 * ReadinessFailureThreshold is the number of consecutive failed checks after which the node is
+                 * reported as not ready, defaults to 3.
+                 */
+                readinessFailureThreshold?: number;
                 /**
                  * SSHProbeConfiguration defines an SSH probe.
                  */
                 sshProbeConfiguration?: {
                     /**
-                     * Password is the password to use for auth.
+                     * CredentialsSecret is the name of a Secret in the namespace of the Topology holding the
+                     * credentials to use for auth. The "username", "password" and "ssh-privatekey" keys (as used
+                     * by the kubernetes.io/basic-auth and kubernetes.io/ssh-auth Secret types) are used if
+                     * present, the username and password set inline take precedence over the ones in the Secret.
+                     * The values are injected into the launcher via environment variable references and so never
+                     * show up in the launcher Deployment itself.
+                     */
+                    credentialsSecret?: string;
+                    /**
+                     * Password is the password to use for auth, required unless CredentialsSecret is set. Note
+                     * that this ends up in plain text in the launcher Deployment, prefer CredentialsSecret.
                      */
-                    password: string;
+                    password?: string;
                     /**
                      * Port is an optional override (of course default is 22).
                      */
                     port?: number;
                     /**
-                     * Username is the username to use for auth.
+                     * Username is the username to use for auth, required unless CredentialsSecret is set.
                      */
-                    username: string;
+                    username?: string;
                 };
                 /**
                  * StartupSeconds is the total amount of seconds to allow for the node to start. This defaults
@@ -1506,6 +2063,10 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
                      */
                     port: number;
                 };
+                /**
+                 * TimeoutSeconds is the timeout for the launcher probe attempts, defaults to 5 seconds.
+                 */
+                timeoutSeconds?: number;
             };
         };
     };
@@ -1566,7 +2127,15 @@ export type ClabernetesContainerlabDevTopologyV1Alpha1 = {
         exposedPorts: {
             [key: string]: {
                 /**
-                 * LoadBalancerAddress holds the address assigned to the load balancer exposing ports for a
+                 * ExternalPorts maps the exposed ports of the node, as "<port>/<protocol>" (i.e. "22/tcp"), to
+                 * the port they are reachable on at the load balancer address. This is only set when using the
+                 * SharedLoadBalancer expose type, as all nodes then share one load balancer address.
+                 */
+                externalPorts?: {
+                    [key: string]: number;
+                };
+                /**
+                 * LoadBalancerAddress holds the address assigned to the load balancer exposing ports for a
                  * given node.
                  */
                 loadBalancerAddress: string;
@@ -1659,6 +2228,133 @@ export type ClabernetesContainerlabDevTopologyListV1Alpha1 = {
     metadata?: IoK8sApimachineryPkgApisMetaV1ListMeta;
 };
 
+/**
+ * TopologySnapshot is an object that represents a request to capture ("containerlab save") the
+ * running configuration of every node in a Topology. The captured configuration artifacts are
+ * stored in a ConfigMap per node, owned by the TopologySnapshot.
+ */
+export type ClabernetesContainerlabDevTopologysnapshotV1Alpha1 = {
+    /**
+     * APIVersion defines the versioned schema of this representation of an object.
+     * Servers should convert recognized schemas to the latest internal value, and
+     * may reject unrecognized values.
+     * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+     */
+    apiVersion?: string;
+    /**
+     * Kind is a string value representing the REST resource this object represents.
+     * Servers may infer this from the endpoint the client submits requests to.
+     * Cannot be updated.
+     * In CamelCase.
+     * More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+     */
+    kind?: string;
+    metadata?: {
+        [key: string]: unknown;
+    };
+    /**
+     * TopologySnapshotSpec is the spec for a TopologySnapshot resource.
+     */
+    spec?: {
+        /**
+         * TopologyName is the name of the Topology (in the same namespace as the TopologySnapshot) to
+         * capture the node configurations of.
+         */
+        topologyName: string;
+    };
+    /**
+     * TopologySnapshotStatus is the status for a TopologySnapshot resource.
+     */
+    status?: {
+        /**
+         * CompletionTime is the time the snapshot finished (successfully or not).
+         */
+        completionTime?: string;
+        /**
+         * Message holds a human-readable message about the phase of the snapshot, for example why the
+         * snapshot failed.
+         */
+        message?: string;
+        /**
+         * Nodes is a map of node name to the snapshot status of that node.
+         */
+        nodes?: {
+            [key: string]: {
+                /**
+                 * Artifacts is the list of configuration artifacts captured for this node.
+                 */
+                artifacts?: Array<{
+                    /**
+                     * Key is the key in the node ConfigMap that holds the contents of the file.
+                     */
+                    key: string;
+                    /**
+                     * Path is the path of the file relative to the node directory in the containerlab lab
+                     * directory.
+                     */
+                    path: string;
+                }>;
+                /**
+                 * ConfigMapName is the name of the ConfigMap the node configuration artifacts are stored in.
+                 */
+                configMapName?: string;
+                /**
+                 * Kind is the containerlab kind of the node.
+                 */
+                kind?: string;
+                /**
+                 * Message holds a human-readable message about the snapshot of this node, for example why the
+                 * snapshot of the node failed.
+                 */
+                message?: string;
+                /**
+                 * Phase is the phase of the snapshot of this node.
+                 */
+                phase: 'pending' | 'succeeded' | 'failed';
+            };
+        };
+        /**
+         * Phase is the overall phase of the snapshot.
+         */
+        phase?: 'pending' | 'running' | 'completed' | 'failed';
+        /**
+         * ProcessedNodes is the number of nodes that have been snapshotted so far, whether
+         * successfully or not.
+         */
+        processedNodes?: number;
+        /**
+         * StartTime is the time the snapshot was started.
+         */
+        startTime?: string;
+        /**
+         * TotalNodes is the number of nodes in the topology being snapshotted.
+         */
+        totalNodes?: number;
+    };
+};
+
+/**
+ * a list of clabernetes-containerlab-dev.topologysnapshot.v1alpha1 resources
+ */
+export type ClabernetesContainerlabDevTopologysnapshotListV1Alpha1 = {
+    /**
+     * APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+     */
+    apiVersion?: string;
+    /**
+     * List of topologysnapshots. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md
+     */
+    items: Array<ClabernetesContainerlabDevTopologysnapshotV1Alpha1>;
+    /**
+     * Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+     */
+    kind?: string;
+    /**
+     * Standard list metadata. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+     */
+    metadata?: IoK8sApimachineryPkgApisMetaV1ListMeta;
+};
+
 export type ListClabernetesContainerlabDevV1Alpha1ConnectivityForAllNamespacesData = {
     body?: never;
     path?: never;
@@ -3583,6 +4279,487 @@ export type ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponses
 
 export type ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponse = ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponses[keyof ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologyResponses];
 
+export type ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        /**
+         * allowWatchBookmarks requests watch events with type "BOOKMARK". Servers that do not implement bookmarks may ignore this flag and bookmarks are sent at the server's discretion. Clients should not assume bookmarks are returned at any specific interval, nor may they assume the server will send any BOOKMARK event during a session. If this is not a watch, this field is ignored.
+         */
+        allowWatchBookmarks?: boolean;
+        /**
+         * The continue option should be set when retrieving more results from the server. Since this value is server defined, clients may only use the continue value from a previous query result with identical query parameters (except for the value of continue) and the server may reject a continue value it does not recognize. If the specified continue value is no longer valid whether due to expiration (generally five to fifteen minutes) or a configuration change on the server, the server will respond with a 410 ResourceExpired error together with a continue token. If the client needs a consistent list, it must restart their list without the continue field. Otherwise, the client may send another list request with the token received with the 410 error, the server will respond with a list starting from the next key, but from the latest snapshot, which is inconsistent from the previous list results - objects that are created, modified, or deleted after the first list request will be included in the response, as long as their keys are after the "next key".
+         *
+         * This field is not supported when watch is true. Clients may start a watch from the last resourceVersion value returned by the server and not miss any modifications.
+         */
+        continue?: string;
+        /**
+         * A selector to restrict the list of returned objects by their fields. Defaults to everything.
+         */
+        fieldSelector?: string;
+        /**
+         * A selector to restrict the list of returned objects by their labels. Defaults to everything.
+         */
+        labelSelector?: string;
+        /**
+         * limit is a maximum number of responses to return for a list call. If more items exist, the server will set the `continue` field on the list metadata to a value that can be used with the same initial query to retrieve the next set of results. Setting a limit may return fewer than the requested amount of items (up to zero items) in the event all requested objects are filtered out and clients should only use the presence of the continue field to determine whether more results are available. Servers may choose not to support the limit argument and will return all of the available results. If limit is specified and the continue field is empty, clients may assume that no more results are available. This field is not supported if watch is true.
+         *
+         * The server guarantees that the objects returned when using continue will be identical to issuing a single list call without a limit - that is, no objects created, modified, or deleted after the first request is issued will be included in any subsequent continued requests. This is sometimes referred to as a consistent snapshot, and ensures that a client that is using limit to receive smaller chunks of a very large result can ensure they see all possible objects. If objects are updated during a chunked list the version of the object that was present at the time the first list result was calculated is returned.
+         */
+        limit?: number;
+        /**
+         * If 'true', then the output is pretty printed.
+         */
+        pretty?: string;
+        /**
+         * resourceVersion sets a constraint on what resource versions a request may be served from. See https://kubernetes.io/docs/reference/using-api/api-concepts/#resource-versions for details.
+         *
+         * Defaults to unset
+         */
+        resourceVersion?: string;
+        /**
+         * resourceVersionMatch determines how resourceVersion is applied to list calls. It is highly recommended that resourceVersionMatch be set for list calls where resourceVersion is set See https://kubernetes.io/docs/reference/using-api/api-concepts/#resource-versions for details.
+         *
+         * Defaults to unset
+         */
+        resourceVersionMatch?: string;
+        /**
+         * Timeout for the list/watch call. This limits the duration of the call, regardless of any activity or inactivity.
+         */
+        timeoutSeconds?: number;
+        /**
+         * Watch for changes to the described resources and return them as a stream of add, update, and remove notifications. Specify resourceVersion.
+         */
+        watch?: boolean;
+    };
+    url: '/apis/clabernetes.containerlab.dev/v1alpha1/topologysnapshots';
+};
+
+export type ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesErrors = {
+    /**
+     * Unauthorized
+     */
+    401: unknown;
+};
+
+export type ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesResponses = {
+    /**
+     * OK
+     */
+    200: ClabernetesContainerlabDevTopologysnapshotListV1Alpha1;
+};
+
+export type ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesResponse = ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesResponses[keyof ListClabernetesContainerlabDevV1Alpha1TopologysnapshotForAllNamespacesResponses];
+
+export type DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotData = {
+    body?: never;
+    path: {
+        /**
+         * object name and auth scope, such as for teams and projects
+         */
+        namespace: string;
+    };
+    query?: {
+        /**
+         * If 'true', then the output is pretty printed.
+         */
+        pretty?: string;
+        /**
+         * allowWatchBookmarks requests watch events with type "BOOKMARK". Servers that do not implement bookmarks may ignore this flag and bookmarks are sent at the server's discretion. Clients should not assume bookmarks are returned at any specific interval, nor may they assume the server will send any BOOKMARK event during a session. If this is not a watch, this field is ignored.
+         */
+        allowWatchBookmarks?: boolean;
+        /**
+         * The continue option should be set when retrieving more results from the server. Since this value is server defined, clients may only use the continue value from a previous query result with identical query parameters (except for the value of continue) and the server may reject a continue value it does not recognize. If the specified continue value is no longer valid whether due to expiration (generally five to fifteen minutes) or a configuration change on the server, the server will respond with a 410 ResourceExpired error together with a continue token. If the client needs a consistent list, it must restart their list without the continue field. Otherwise, the client may send another list request with the token received with the 410 error, the server will respond with a list starting from the next key, but from the latest snapshot, which is inconsistent from the previous list results - objects that are created, modified, or deleted after the first list request will be included in the response, as long as their keys are after the "next key".
+         *
+         * This field is not supported when watch is true. Clients may start a watch from the last resourceVersion value returned by the server and not miss any modifications.
+         */
+        continue?: string;
+        /**
+         * A selector to restrict the list of returned objects by their fields. Defaults to everything.
+         */
+        fieldSelector?: string;
+        /**
+         * A selector to restrict the list of returned objects by their labels. Defaults to everything.
+         */
+        labelSelector?: string;
+        /**
+         * limit is a maximum number of responses to return for a list call. If more items exist, the server will set the `continue` field on the list metadata to a value that can be used with the same initial query to retrieve the next set of results. Setting a limit may return fewer than the requested amount of items (up to zero items) in the event all requested objects are filtered out and clients should only use the presence of the continue field to determine whether more results are available. Servers may choose not to support the limit argument and will return all of the available results. If limit is specified and the continue field is empty, clients may assume that no more results are available. This field is not supported if watch is true.
+         *
+         * The server guarantees that the objects returned when using continue will be identical to issuing a single list call without a limit - that is, no objects created, modified, or deleted after the first request is issued will be included in any subsequent continued requests. This is sometimes referred to as a consistent snapshot, and ensures that a client that is using limit to receive smaller chunks of a very large result can ensure they see all possible objects. If objects are updated during a chunked list the version of the object that was present at the time the first list result was calculated is returned.
+         */
+        limit?: number;
+        /**
+         * resourceVersion sets a constraint on what resource versions a request may be served from. See https://kubernetes.io/docs/reference/using-api/api-concepts/#resource-versions for details.
+         *
+         * Defaults to unset
+         */
+        resourceVersion?: string;
+        /**
+         * resourceVersionMatch determines how resourceVersion is applied to list calls. It is highly recommended that resourceVersionMatch be set for list calls where resourceVersion is set See https://kubernetes.io/docs/reference/using-api/api-concepts/#resource-versions for details.
+         *
+         * Defaults to unset
+         */
+        resourceVersionMatch?: string;
+        /**
+         * Timeout for the list/watch call. This limits the duration of the call, regardless of any activity or inactivity.
+         */
+        timeoutSeconds?: number;
+        /**
+         * Watch for changes to the described resources and return them as a stream of add, update, and remove notifications. Specify resourceVersion.
+         */
+        watch?: boolean;
+    };
+    url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots';
+};
+
+export type DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotErrors = {
+    /**
+     * Unauthorized
+     */
+    401: unknown;
+};
+
+export type DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotResponses = {
+    /**
+     * OK
+     */
+    200: IoK8sApimachineryPkgApisMetaV1Status;
+};
+
+export type DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotResponse = DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotResponses[keyof DeleteClabernetesContainerlabDevV1Alpha1CollectionNamespacedTopologysnapshotResponses];
+
+export type ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData = {
+    body?: never;
+    path: {
+        /**
+         * object name and auth scope, such as for teams and projects
+         */
+        namespace: string;
+    };
+    query?: {
+        /**
+         * If 'true', then the output is pretty printed.
+         */
+        pretty?: string;
+        /**
+         * allowWatchBookmarks requests watch events with type "BOOKMARK". Servers that do not implement bookmarks may ignore this flag and bookmarks are sent at the server's discretion. Clients should not assume bookmarks are returned at any specific interval, nor may they assume the server will send any BOOKMARK event during a session. If this is not a watch, this field is ignored.
+         */
+        allowWatchBookmarks?: boolean;
+        /**
+         * The continue option should be set when retrieving more results from the server. Since this value is server defined, clients may only use the continue value from a previous query result with identical query parameters (except for the value of continue) and the server may reject a continue value it does not recognize. If the specified continue value is no longer valid whether due to expiration (generally five to fifteen minutes) or a configuration change on the server, the server will respond with a 410 ResourceExpired error together with a continue token. If the client needs a consistent list, it must restart their list without the continue field. Otherwise, the client may send another list request with the token received with the 410 error, the server will respond with a list starting from the next key, but from the latest snapshot, which is inconsistent from the previous list results - objects that are created, modified, or deleted after the first list request will be included in the response, as long as their keys are after the "next key".
+         *
+         * This field is not supported when watch is true. Clients may start a watch from the last resourceVersion value returned by the server and not miss any modifications.
+         */
+        continue?: string;
+        /**
+         * A selector to restrict the list of returned objects by their fields. Defaults to everything.
+         */
+        fieldSelector?: string;
+        /**
+         * A selector to restrict the list of returned objects by their labels. Defaults to everything.
+         */
+        labelSelector?: string;
+        /**
+         * limit is a maximum number of responses to return for a list call. If more items exist, the server will set the `continue` field on the list metadata to a value that can be used with the same initial query to retrieve the next set of results. Setting a limit may return fewer than the requested amount of items (up to zero items) in the event all requested objects are filtered out and clients should only use the presence of the continue field to determine whether more results are available. Servers may choose not to support the limit argument and will return all of the available results. If limit is specified and the continue field is empty, clients may assume that no more results are available. This field is not supported if watch is true.
+         *
+         * The server guarantees that the objects returned when using continue will be identical to issuing a single list call without a limit - that is, no objects created, modified, or deleted after the first request is issued will be included in any subsequent continued requests. This is sometimes referred to as a consistent snapshot, and ensures that a client that is using limit to receive smaller chunks of a very large result can ensure they see all possible objects. If objects are updated during a chunked list the version of the object that was present at the time the first list result was calculated is returned.
+         */
+        limit?: number;
+        /**
+         * resourceVersion sets a constraint on what resource versions a request may be served from. See https://kubernetes.io/docs/reference/using-api/api-concepts/#resource-versions for details.
+         *
+         * Defaults to unset
+         */
+        resourceVersion?: string;
+        /**
+         * resourceVersionMatch determines how resourceVersion is applied to list calls. It is highly recommended that resourceVersionMatch be set for list calls where resourceVersion is set See https://kubernetes.io/docs/reference/using-api/api-concepts/#resource-versions for details.
+         *
+         * Defaults to unset
+         */
+        resourceVersionMatch?: string;
+        /**
+         * Timeout for the list/watch call. This limits the duration of the call, regardless of any activity or inactivity.
+         */
+        timeoutSeconds?: number;
+        /**
+         * Watch for changes to the described resources and return them as a stream of add, update, and remove notifications. Specify resourceVersion.
+         */
+        watch?: boolean;
+    };
+    url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots';
+};
+
+export type ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotErrors = {
+    /**
+     * Unauthorized
+     */
+    401: unknown;
+};
+
+export type ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses = {
+    /**
+     * OK
+     */
+    200: ClabernetesContainerlabDevTopologysnapshotListV1Alpha1;
+};
+
+export type ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse = ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses[keyof ListClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses];
+
+export type CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData = {
+    body?: ClabernetesContainerlabDevTopologysnapshotV1Alpha1;
+    path: {
+        /**
+         * object name and auth scope, such as for teams and projects
+         */
+        namespace: string;
+    };
+    query?: {
+        /**
+         * If 'true', then the output is pretty printed.
+         */
+        pretty?: string;
+        /**
+         * When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed
+         */
+        dryRun?: string;
+        /**
+         * fieldManager is a name associated with the actor or entity that is making these changes. The value must be less than or 128 characters long, and only contain printable characters, as defined by https://golang.org/pkg/unicode/#IsPrint.
+         */
+        fieldManager?: string;
+        /**
+         * fieldValidation instructs the server on how to handle objects in the request (POST/PUT/PATCH) containing unknown or duplicate fields, provided that the `ServerSideFieldValidation` feature gate is also enabled. Valid values are: - Ignore: This will ignore any unknown fields that are silently dropped from the object, and will ignore all but the last duplicate field that the decoder encounters. This is the default behavior prior to v1.23 and is the default behavior when the `ServerSideFieldValidation` feature gate is disabled. - Warn: This will send a warning via the standard warning response header for each unknown field that is dropped from the object, and for each duplicate field that is encountered. The request will still succeed if there are no other errors, and will only persist the last of any duplicate fields. This is the default when the `ServerSideFieldValidation` feature gate is enabled. - Strict: This will fail the request with a BadRequest error if any unknown fields would be dropped from the object, or if any duplicate fields are present. The error returned from the server will contain all unknown and duplicate fields encountered.
+         */
+        fieldValidation?: string;
+    };
+    url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots';
+};
+
+export type CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotErrors = {
+    /**
+     * Unauthorized
+     */
+    401: unknown;
+};
+
+export type CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses = {
+    /**
+     * OK
+     */
+    200: ClabernetesContainerlabDevTopologysnapshotV1Alpha1;
+    /**
+     * Created
+     */
+    201: ClabernetesContainerlabDevTopologysnapshotV1Alpha1;
+    /**
+     * Accepted
+     */
+    202: ClabernetesContainerlabDevTopologysnapshotV1Alpha1;
+};
+
+export type CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse = CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses[keyof CreateClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses];
+
+export type DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData = {
+    body?: IoK8sApimachineryPkgApisMetaV1DeleteOptions;
+    path: {
+        /**
+         * name of the Topologysnapshot
+         */
+        name: string;
+        /**
+         * object name and auth scope, such as for teams and projects
+         */
+        namespace: string;
+    };
+    query?: {
+        /**
+         * If 'true', then the output is pretty printed.
+         */
+        pretty?: string;
+        /**
+         * When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed
+         */
+        dryRun?: string;
+        /**
+         * The duration in seconds before the object should be deleted. Value must be non-negative integer. The value zero indicates delete immediately. If this value is nil, the default grace period for the specified type will be used. Defaults to a per object value if not specified. zero means delete immediately.
+         */
+        gracePeriodSeconds?: number;
+        /**
+         * Deprecated: please use the PropagationPolicy, this field will be deprecated in 1.7. Should the dependent objects be orphaned. If true/false, the "orphan" finalizer will be added to/removed from the object's finalizers list. Either this field or PropagationPolicy may be set, but not both.
+         */
+        orphanDependents?: boolean;
+        /**
+         * Whether and how garbage collection will be performed. Either this field or OrphanDependents may be set, but not both. The default policy is decided by the existing finalizer set in the metadata.finalizers and the resource-specific default policy. Acceptable values are: 'Orphan' - orphan the dependents; 'Background' - allow the garbage collector to delete the dependents in the background; 'Foreground' - a cascading policy that deletes all dependents in the foreground.
+         */
+        propagationPolicy?: string;
+    };
+    url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots/{name}';
+};
+
+export type DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotErrors = {
+    /**
+     * Unauthorized
+     */
+    401: unknown;
+};
+
+export type DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses = {
+    /**
+     * OK
+     */
+    200: IoK8sApimachineryPkgApisMetaV1Status;
+    /**
+     * Accepted
+     */
+    202: IoK8sApimachineryPkgApisMetaV1Status;
+};
+
+export type DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse = DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses[keyof DeleteClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses];
+
+export type ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData = {
+    body?: never;
+    path: {
+        /**
+         * name of the Topologysnapshot
+         */
+        name: string;
+        /**
+         * object name and auth scope, such as for teams and projects
+         */
+        namespace: string;
+    };
+    query?: {
+        /**
+         * If 'true', then the output is pretty printed.
+         */
+        pretty?: string;
+        /**
+         * resourceVersion sets a constraint on what resource versions a request may be served from. See https://kubernetes.io/docs/reference/using-api/api-concepts/#resource-versions for details.
+         *
+         * Defaults to unset
+         */
+        resourceVersion?: string;
+    };
+    url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots/{name}';
+};
+
+export type ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotErrors = {
+    /**
+     * Unauthorized
+     */
+    401: unknown;
+};
+
+export type ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses = {
+    /**
+     * OK
+     */
+    200: ClabernetesContainerlabDevTopologysnapshotV1Alpha1;
+};
+
+export type ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse = ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses[keyof ReadClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses];
+
+export type PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData = {
+    body?: IoK8sApimachineryPkgApisMetaV1Patch;
+    path: {
+        /**
+         * name of the Topologysnapshot
+         */
+        name: string;
+        /**
+         * object name and auth scope, such as for teams and projects
+         */
+        namespace: string;
+    };
+    query?: {
+        /**
+         * If 'true', then the output is pretty printed.
+         */
+        pretty?: string;
+        /**
+         * When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed
+         */
+        dryRun?: string;
+        /**
+         * fieldManager is a name associated with the actor or entity that is making these changes. The value must be less than or 128 characters long, and only contain printable characters, as defined by https://golang.org/pkg/unicode/#IsPrint.
+         */
+        fieldManager?: string;
+        /**
+         * fieldValidation instructs the server on how to handle objects in the request (POST/PUT/PATCH) containing unknown or duplicate fields, provided that the `ServerSideFieldValidation` feature gate is also enabled. Valid values are: - Ignore: This will ignore any unknown fields that are silently dropped from the object, and will ignore all but the last duplicate field that the decoder encounters. This is the default behavior prior to v1.23 and is the default behavior when the `ServerSideFieldValidation` feature gate is disabled. - Warn: This will send a warning via the standard warning response header for each unknown field that is dropped from the object, and for each duplicate field that is encountered. The request will still succeed if there are no other errors, and will only persist the last of any duplicate fields. This is the default when the `ServerSideFieldValidation` feature gate is enabled. - Strict: This will fail the request with a BadRequest error if any unknown fields would be dropped from the object, or if any duplicate fields are present. The error returned from the server will contain all unknown and duplicate fields encountered.
+         */
+        fieldValidation?: string;
+    };
+    url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots/{name}';
+};
+
+export type PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotErrors = {
+    /**
+     * Unauthorized
+     */
+    401: unknown;
+};
+
+export type PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses = {
+    /**
+     * OK
+     */
+    200: ClabernetesContainerlabDevTopologysnapshotV1Alpha1;
+};
+
+export type PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse = PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses[keyof PatchClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses];
+
+export type ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotData = {
+    body?: ClabernetesContainerlabDevTopologysnapshotV1Alpha1;
+    path: {
+        /**
+         * name of the Topologysnapshot
+         */
+        name: string;
+        /**
+         * object name and auth scope, such as for teams and projects
+         */
+        namespace: string;
+    };
+    query?: {
+        /**
+         * If 'true', then the output is pretty printed.
+         */
+        pretty?: string;
+        /**
+         * When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed
+         */
+        dryRun?: string;
+        /**
+         * fieldManager is a name associated with the actor or entity that is making these changes. The value must be less than or 128 characters long, and only contain printable characters, as defined by https://golang.org/pkg/unicode/#IsPrint.
+         */
+        fieldManager?: string;
+        /**
+         * fieldValidation instructs the server on how to handle objects in the request (POST/PUT/PATCH) containing unknown or duplicate fields, provided that the `ServerSideFieldValidation` feature gate is also enabled. Valid values are: - Ignore: This will ignore any unknown fields that are silently dropped from the object, and will ignore all but the last duplicate field that the decoder encounters. This is the default behavior prior to v1.23 and is the default behavior when the `ServerSideFieldValidation` feature gate is disabled. - Warn: This will send a warning via the standard warning response header for each unknown field that is dropped from the object, and for each duplicate field that is encountered. The request will still succeed if there are no other errors, and will only persist the last of any duplicate fields. This is the default when the `ServerSideFieldValidation` feature gate is enabled. - Strict: This will fail the request with a BadRequest error if any unknown fields would be dropped from the object, or if any duplicate fields are present. The error returned from the server will contain all unknown and duplicate fields encountered.
+         */
+        fieldValidation?: string;
+    };
+    url: '/apis/clabernetes.containerlab.dev/v1alpha1/namespaces/{namespace}/topologysnapshots/{name}';
+};
+
+export type ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotErrors = {
+    /**
+     * Unauthorized
+     */
+    401: unknown;
+};
+
+export type ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses = {
+    /**
+     * OK
+     */
+    200: ClabernetesContainerlabDevTopologysnapshotV1Alpha1;
+    /**
+     * Created
+     */
+    201: ClabernetesContainerlabDevTopologysnapshotV1Alpha1;
+};
+
+export type ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponse = ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses[keyof ReplaceClabernetesContainerlabDevV1Alpha1NamespacedTopologysnapshotResponses];
+
 export type ClientOptions = {
     baseUrl: `${string}://clabernetes-openapi.json` | (string & {});
 };
\ No newline at end of file
@@ -0,0 +1,19 @@
+package containerlab
+
+import (
+	"os"
+
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+)
+
+// RuntimeArgs returns the containerlab args selecting the runtime for the launcher runtime in use
+// -- nothing for docker as that is the containerlab default anyway.
+func RuntimeArgs() []string {
+	if os.Getenv(
+		clabernetesconstants.LauncherRuntimeEnv,
+	) == clabernetesconstants.LauncherRuntimePodman {
+		return []string{"--runtime", clabernetesconstants.LauncherRuntimePodman}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package containerlab_test
+
+import (
+	"testing"
+
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
+	clabernetestesthelper "github.com/srl-labs/clabernetes/testhelper"
+	clabernetesutilcontainerlab "github.com/srl-labs/clabernetes/util/containerlab"
+)
+
+func TestRuntimeArgs(t *testing.T) {
+	cases := []struct {
+		name     string
+		runtime  string
+		expected []string
+	}{
+		{
+			name:     "unset",
+			runtime:  "",
+			expected: nil,
+		},
+		{
+			name:     "docker",
+			runtime:  clabernetesconstants.LauncherRuntimeDocker,
+			expected: nil,
+		},
+		{
+			name:     "podman",
+			runtime:  clabernetesconstants.LauncherRuntimePodman,
+			expected: []string{"--runtime", "podman"},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(
+			testCase.name,
+			func(t *testing.T) {
+				t.Logf("%s: starting", testCase.name)
+
+				t.Setenv(clabernetesconstants.LauncherRuntimeEnv, testCase.runtime)
+
+				actual := clabernetesutilcontainerlab.RuntimeArgs()
+
+				if len(actual) != len(testCase.expected) {
+					clabernetestesthelper.FailOutput(t, actual, testCase.expected)
+				}
+
+				for idx := range actual {
+					if actual[idx] != testCase.expected[idx] {
+						clabernetestesthelper.FailOutput(t, actual, testCase.expected)
+					}
+				}
+			})
+	}
+}
@@ -1,6 +1,9 @@
 package containerlab
 
 import (
+	"fmt"
+
+	claberneteserrors "github.com/srl-labs/clabernetes/errors"
 	"gopkg.in/yaml.v3"
 )
 
@@ -13,6 +16,13 @@ func LoadContainerlabConfig(rawConfig string) (*Config, error) {
 		return nil, err
 	}
 
+	if config.Topology == nil {
+		return nil, fmt.Errorf(
+			"%w: containerlab config does not contain a topology section",
+			claberneteserrors.ErrParse,
+		)
+	}
+
 	if config.Topology.Defaults == nil {
 		// defaults was nil, thats ok, but we'll just instantiate an empty definition so we don't
 		// have to check that its nil before checking for stuff inside it being nil/empty too
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"maps"
 
+	clabernetesconstants "github.com/srl-labs/clabernetes/constants"
 	claberneteserrors "github.com/srl-labs/clabernetes/errors"
 )
 
@@ -19,6 +20,11 @@ type Config struct {
 	Topology *Topology `yaml:"topology,omitempty"`
 	// Debug mode flag
 	Debug bool `yaml:"debug"`
+	// WaitFor holds the (primary) nodes of other sub-topologies this sub-topology depends on via
+	// wait-for/stages -- containerlab cannot handle those as the nodes do not exist in the
+	// sub-topology, so this is clabernetes only and never rendered, the launcher waits for them
+	// to be ready before deploying the sub-topology instead.
+	WaitFor []string `yaml:"-"`
 }
 
 // MgmtNet struct defines the management network options.
@@ -75,6 +81,14 @@ func (t *Topology) GetNodeKindType(nodeName string) (
 	return containerlabKind, containerlabType
 }
 
+// IsSegmentNode returns true if the given node is a LAN segment (bridge or ovs-bridge) node.
+func (t *Topology) IsSegmentNode(nodeName string) bool {
+	containerlabKind, _ := t.GetNodeKindType(nodeName)
+
+	return containerlabKind == clabernetesconstants.ContainerlabKindBridge ||
+		containerlabKind == clabernetesconstants.ContainerlabKindOVSBridge
+}
+
 // GetNodeImage returns the resolved image for the given node.
 func (t *Topology) GetNodeImage(nodeName string) string {
 	containerlabKind, _ := t.GetNodeKindType(nodeName)
@@ -177,6 +191,8 @@ type NodeDefinition struct {
 	Extras *Extras `yaml:"extras,omitempty"`
 	// List of node names to wait for before satarting this particular node
 	WaitFor []string `yaml:"wait-for,omitempty"`
+	// Stages (and their dependencies/exec commands) of the node's lifecycle
+	Stages *Stages `yaml:"stages,omitempty"`
 	// DNS configuration
 	DNS *DNSConfig `yaml:"dns,omitempty"`
 	// Certificate Configuration
@@ -188,6 +204,48 @@ type NodeDefinition struct {
 	Components []*Component `yaml:"components,omitempty"`
 }
 
+// Stages represents the lifecycle stages of a node, each of which can wait for other nodes to
+// reach a given stage and/or execute commands.
+type Stages struct {
+	Create      *Stage `yaml:"create,omitempty"`
+	CreateLinks *Stage `yaml:"create-links,omitempty"`
+	Configure   *Stage `yaml:"configure,omitempty"`
+	Healthy     *Stage `yaml:"healthy,omitempty"`
+	Exit        *Stage `yaml:"exit,omitempty"`
+}
+
+// All returns all non nil stages.
+func (s *Stages) All() []*Stage {
+	stages := make([]*Stage, 0)
+
+	for _, stage := range []*Stage{s.Create, s.CreateLinks, s.Configure, s.Healthy, s.Exit} {
+		if stage != nil {
+			stages = append(stages, stage)
+		}
+	}
+
+	return stages
+}
+
+// Stage represents a single lifecycle stage of a node.
+type Stage struct {
+	WaitFor []*StageWaitFor `yaml:"wait-for,omitempty"`
+	Exec    []*StageExec    `yaml:"exec,omitempty"`
+}
+
+// StageWaitFor represents a dependency of a stage on another node reaching a given stage.
+type StageWaitFor struct {
+	Node  string `yaml:"node"`
+	Stage string `yaml:"stage,omitempty"`
+}
+
+// StageExec represents a command executed when entering or exiting a stage.
+type StageExec struct {
+	Command string `yaml:"command"`
+	Target  string `yaml:"target,omitempty"`
+	Phase   string `yaml:"phase,omitempty"`
+}
+
 // ConfigDispatcher represents the config of a configuration machine
 // that is responsible to execute configuration commands on the nodes
 // after they started.
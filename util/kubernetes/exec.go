@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	k8scorev1 "k8s.io/api/core/v1"
+	apimachineryhttpstream "k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clientgorest "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ExecPodContainer executes the given command in the given container of a pod and returns the
+// stdout and stderr output of the command. Like kubectl, the websocket exec protocol is tried
+// first, falling back to spdy if the api server does not support websockets.
+func ExecPodContainer(
+	ctx context.Context,
+	config *clientgorest.Config,
+	kubeClient kubernetes.Interface,
+	namespace,
+	podName,
+	containerName string,
+	command []string,
+) (stdout, stderr []byte, err error) {
+	req := kubeClient.CoreV1().RESTClient().
+		Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(
+			&k8scorev1.PodExecOptions{
+				Container: containerName,
+				Command:   command,
+				Stdout:    true,
+				Stderr:    true,
+			},
+			clientgoscheme.ParameterCodec,
+		)
+
+	websocketExecutor, err := remotecommand.NewWebSocketExecutor(
+		config,
+		http.MethodGet,
+		req.URL().String(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	spdyExecutor, err := remotecommand.NewSPDYExecutor(config, http.MethodPost, req.URL())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executor, err := remotecommand.NewFallbackExecutor(
+		websocketExecutor,
+		spdyExecutor,
+		func(err error) bool {
+			return apimachineryhttpstream.IsUpgradeFailure(err) ||
+				apimachineryhttpstream.IsHTTPSProxyError(err)
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stdoutBuf, stderrBuf bytes.Buffer
+
+	err = executor.StreamWithContext(
+		ctx,
+		remotecommand.StreamOptions{
+			Stdout: &stdoutBuf,
+			Stderr: &stderrBuf,
+		},
+	)
+
+	return stdoutBuf.Bytes(), stderrBuf.Bytes(), err
+}
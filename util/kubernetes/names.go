@@ -6,6 +6,8 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+
+	clabernetesutil "github.com/srl-labs/clabernetes/util"
 )
 
 var (
@@ -43,6 +45,16 @@ func SafeConcatNameKubernetes(name ...string) string {
 	return SafeConcatNameMax(name, NameMaxLen)
 }
 
+// ImageRequestName returns the name of the ImageRequest for the given image on the given kubernetes
+// node -- the name is deterministic so that all requesters of an image on a node (launchers and
+// the controller when prewarming images) end up sharing one ImageRequest.
+func ImageRequestName(kubernetesNode, imageName string) string {
+	// hash the image name so it doesn't contain invalid chars for k8s name
+	return SafeConcatNameKubernetes(
+		kubernetesNode, clabernetesutil.HashBytes([]byte(imageName)),
+	)
+}
+
 // SafeConcatNameMax concats all provided strings into a string joined by "-" - if the final string
 // is greater than max characters, the string will be shortened, and a hash will be used at the end
 // of the string to keep it unique, but safely within allowed lengths.